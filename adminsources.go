@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mmcdole/gofeed"
+)
+
+const newsSourcesFile = "news_sources.json"
+
+// addNewsSourceHandler serves POST /admin/sources: it accepts an
+// RSSSourceConfig body, verifies the URL both looks like a feed URL and
+// actually parses as one, then appends it to news_sources.json. No restart
+// or explicit reload is needed afterwards: fetchRSSFeeds already reloads
+// news_sources.json from disk on every crawl, so the new source is live on
+// the next search.
+func addNewsSourceHandler(c *gin.Context) {
+	if !requireUnrestricted(c) {
+		return
+	}
+
+	var cfg RSSSourceConfig
+	if err := c.ShouldBindJSON(&cfg); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := validateRSSSourceURL(cfg.URL); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if err := validateFeedParses(c.Request.Context(), cfg); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("URL doesn't parse as an RSS/Atom feed: %s", err)})
+		return
+	}
+
+	configs, err := loadNewsSourceConfigs(newsSourcesFile)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	for _, existing := range configs {
+		if existing.URL == cfg.URL {
+			c.JSON(http.StatusConflict, gin.H{"error": "source already exists"})
+			return
+		}
+	}
+	configs = append(configs, cfg)
+
+	if err := saveNewsSourceConfigs(newsSourcesFile, configs); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"source": cfg})
+}
+
+// removeNewsSourceHandler serves DELETE /admin/sources?url=..., dropping
+// the matching entry from news_sources.json.
+// listTwitterHandlesHandler serves GET /admin/twitter-handles, the current
+// in-memory (live-reloadable, see reload.go) handle list.
+func listTwitterHandlesHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"handles": currentTwitterHandles()})
+}
+
+// listNewsSourcesHandler serves GET /admin/sources, so the admin UI
+// (adminui.go) and other tooling can show the current source list without
+// hand-reading news_sources.json.
+func listNewsSourcesHandler(c *gin.Context) {
+	configs, err := loadNewsSourceConfigs(newsSourcesFile)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"sources": configs})
+}
+
+func removeNewsSourceHandler(c *gin.Context) {
+	if !requireUnrestricted(c) {
+		return
+	}
+
+	targetURL := c.Query("url")
+	if targetURL == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "url is required"})
+		return
+	}
+
+	configs, err := loadNewsSourceConfigs(newsSourcesFile)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	remaining := make([]RSSSourceConfig, 0, len(configs))
+	found := false
+	for _, cfg := range configs {
+		if cfg.URL == targetURL {
+			found = true
+			continue
+		}
+		remaining = append(remaining, cfg)
+	}
+	if !found {
+		c.JSON(http.StatusNotFound, gin.H{"error": "source not found"})
+		return
+	}
+
+	if err := saveNewsSourceConfigs(newsSourcesFile, remaining); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// validateFeedParses fetches cfg.URL (through the same client fetchRSSFeeds
+// would use, so auth/headers apply) and confirms gofeed can parse it,
+// rejecting URLs that are syntactically fine but don't actually serve
+// RSS/Atom/JSON feed content.
+func validateFeedParses(ctx context.Context, cfg RSSSourceConfig) error {
+	fp := gofeed.NewParser()
+	if client := httpClientForSource(cfg); client != nil {
+		fp.Client = client
+	}
+	_, err := fp.ParseURLWithContext(cfg.URL, ctx)
+	return err
+}
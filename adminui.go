@@ -0,0 +1,20 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// adminPageHandler serves GET /admin, a server-rendered dashboard for
+// curating what the aggregator monitors (sources, Twitter handles,
+// watchlist overrides, alert states) without needing to script the JSON
+// APIs directly. It's gated the same way every other admin action in this
+// repo is (requireUnrestricted, roles.go) since there's no real session
+// system to scope a dedicated "admin" role to.
+func adminPageHandler(c *gin.Context) {
+	if !requireUnrestricted(c) {
+		return
+	}
+	c.HTML(http.StatusOK, "admin.html", gin.H{})
+}
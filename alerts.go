@@ -0,0 +1,250 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AlertState tracks the acknowledge/snooze status of alerts firing for a
+// single watched keyword. Acknowledging or snoozing an alert prevents
+// duplicate notifications until the snooze window expires.
+type AlertState struct {
+	Keyword      string    `json:"keyword"`
+	AckedBy      string    `json:"ackedBy,omitempty"`
+	AckedAt      time.Time `json:"ackedAt,omitempty"`
+	SnoozedUntil time.Time `json:"snoozedUntil,omitempty"`
+}
+
+var (
+	alertStates     = make(map[string]*AlertState)
+	alertStatesLock sync.Mutex
+)
+
+const alertStatesFile = "alert_states.json"
+
+// defaultAckSuppressionWindow is how long an acknowledgment keeps
+// suppressing further notifications for the same keyword when
+// ALERT_ACK_SUPPRESSION_MINUTES isn't set. An ack isn't forever: a keyword
+// that keeps firing well past this window is treated as a new incident
+// again, so someone acknowledging once doesn't silence it permanently.
+const defaultAckSuppressionWindow = 60 * time.Minute
+
+var ackSuppressionWindow = defaultAckSuppressionWindow
+
+// loadAlertConfig applies an optional ALERT_ACK_SUPPRESSION_MINUTES
+// environment override, following the same pattern as loadSchedulerConfig.
+func loadAlertConfig() {
+	if raw := os.Getenv("ALERT_ACK_SUPPRESSION_MINUTES"); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			ackSuppressionWindow = time.Duration(n) * time.Minute
+		}
+	}
+}
+
+func loadAlertStates() {
+	file, err := os.Open(alertStatesFile)
+	if err != nil {
+		slog.Info(fmt.Sprintf("No existing alert states file found: %s", err))
+		return
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+	if err := decoder.Decode(&alertStates); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding alert states file: %s", err))
+	}
+}
+
+func saveAlertStates() {
+	file, err := os.Create(alertStatesFile)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error saving alert states file: %s", err))
+		return
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	if err := encoder.Encode(alertStates); err != nil {
+		slog.Error(fmt.Sprintf("Error encoding alert states file: %s", err))
+	}
+}
+
+// notifiedResultIDs tracks which result IDs have already triggered a
+// notification for a given keyword, so overlapping or retried crawls never
+// notify twice for the same story (exactly-once delivery keyed by
+// (rule, result ID), where the watched keyword is the rule).
+var (
+	notifiedResultIDs     = make(map[string]map[string]bool)
+	notifiedResultIDsLock sync.Mutex
+)
+
+const notifiedResultIDsFile = "notified_result_ids.json"
+
+// maxNotifiedIDsPerKeyword bounds how many delivered IDs are remembered per
+// keyword so the dedup set doesn't grow forever.
+const maxNotifiedIDsPerKeyword = 500
+
+func loadNotifiedResultIDs() {
+	file, err := os.Open(notifiedResultIDsFile)
+	if err != nil {
+		slog.Info(fmt.Sprintf("No existing notified result IDs file found: %s", err))
+		return
+	}
+	defer file.Close()
+
+	decoder := json.NewDecoder(file)
+	if err := decoder.Decode(&notifiedResultIDs); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding notified result IDs file: %s", err))
+	}
+}
+
+func saveNotifiedResultIDs() {
+	file, err := os.Create(notifiedResultIDsFile)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error saving notified result IDs file: %s", err))
+		return
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	if err := encoder.Encode(notifiedResultIDs); err != nil {
+		slog.Error(fmt.Sprintf("Error encoding notified result IDs file: %s", err))
+	}
+}
+
+// notifyIfNew delivers a notification for result under keyword's alert rule
+// exactly once, no matter how many times an overlapping or retried crawl
+// re-discovers the same result ID. Returns whether a notification was
+// actually delivered.
+func notifyIfNew(keyword string, result FeedResult) bool {
+	if isAlertSuppressed(keyword) {
+		return false
+	}
+
+	notifiedResultIDsLock.Lock()
+	defer notifiedResultIDsLock.Unlock()
+
+	delivered := notifiedResultIDs[keyword]
+	if delivered == nil {
+		delivered = make(map[string]bool)
+		notifiedResultIDs[keyword] = delivered
+	}
+	if delivered[result.ID] {
+		return false
+	}
+
+	delivered[result.ID] = true
+	if len(delivered) > maxNotifiedIDsPerKeyword {
+		// Maps don't preserve insertion order; the embedded database isn't
+		// meant to be an exact LRU, just bounded, so drop an arbitrary entry.
+		for id := range delivered {
+			delete(delivered, id)
+			break
+		}
+	}
+	saveNotifiedResultIDs()
+
+	slog.Info(fmt.Sprintf("ALERT [%s]: new result %q (%s)", keyword, result.Title, result.Link))
+	return true
+}
+
+// isAlertSuppressed reports whether a notification for keyword should be
+// held back because it was recently acknowledged or is currently snoozed.
+// A live ack only suppresses for ackSuppressionWindow — past that, the
+// keyword is assumed to be firing on a new incident, not the one that was
+// acknowledged, and notifications resume.
+func isAlertSuppressed(keyword string) bool {
+	alertStatesLock.Lock()
+	defer alertStatesLock.Unlock()
+
+	state, ok := alertStates[keyword]
+	if !ok {
+		return false
+	}
+	if !state.SnoozedUntil.IsZero() && time.Now().Before(state.SnoozedUntil) {
+		return true
+	}
+	if !state.AckedAt.IsZero() && time.Now().Before(state.AckedAt.Add(ackSuppressionWindow)) {
+		return true
+	}
+	return false
+}
+
+// ackAlertHandler acknowledges the currently firing alert for a keyword,
+// recording who acknowledged it so duplicate notifications stop.
+func ackAlertHandler(c *gin.Context) {
+	if !requireUnrestricted(c) {
+		return
+	}
+	keyword := c.Param("keyword")
+	ackedBy := c.PostForm("ackedBy")
+	if ackedBy == "" {
+		ackedBy = "anonymous"
+	}
+
+	alertStatesLock.Lock()
+	state, ok := alertStates[keyword]
+	if !ok {
+		state = &AlertState{Keyword: keyword}
+		alertStates[keyword] = state
+	}
+	state.AckedBy = ackedBy
+	state.AckedAt = time.Now()
+	saveAlertStates()
+	alertStatesLock.Unlock()
+
+	c.JSON(http.StatusOK, gin.H{"status": "acknowledged", "keyword": keyword, "ackedBy": ackedBy})
+}
+
+// snoozeAlertHandler suppresses notifications for a keyword's alert for the
+// given number of minutes (defaults to 60).
+func snoozeAlertHandler(c *gin.Context) {
+	if !requireUnrestricted(c) {
+		return
+	}
+	keyword := c.Param("keyword")
+	minutesParam := c.PostForm("minutes")
+	minutes := 60
+	if minutesParam != "" {
+		parsed, err := strconv.Atoi(minutesParam)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "minutes must be a positive integer"})
+			return
+		}
+		minutes = parsed
+	}
+	if minutes <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "minutes must be a positive integer"})
+		return
+	}
+
+	alertStatesLock.Lock()
+	state, ok := alertStates[keyword]
+	if !ok {
+		state = &AlertState{Keyword: keyword}
+		alertStates[keyword] = state
+	}
+	state.SnoozedUntil = time.Now().Add(time.Duration(minutes) * time.Minute)
+	saveAlertStates()
+	alertStatesLock.Unlock()
+
+	c.JSON(http.StatusOK, gin.H{"status": "snoozed", "keyword": keyword, "snoozedUntil": state.SnoozedUntil})
+}
+
+// listAlertStatesHandler serves GET /admin/alerts, the current
+// acknowledge/snooze state of every keyword with an alert history, for the
+// admin UI (adminui.go).
+func listAlertStatesHandler(c *gin.Context) {
+	alertStatesLock.Lock()
+	defer alertStatesLock.Unlock()
+
+	c.JSON(http.StatusOK, gin.H{"alerts": alertStates})
+}
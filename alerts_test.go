@@ -0,0 +1,83 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// withCleanAlertState resets the package-level alertStates map before and
+// after t so tests don't leak state into each other or the alert_states.json
+// file on disk.
+func withCleanAlertState(t *testing.T) {
+	t.Helper()
+	alertStatesLock.Lock()
+	original := alertStates
+	alertStates = make(map[string]*AlertState)
+	alertStatesLock.Unlock()
+
+	t.Cleanup(func() {
+		alertStatesLock.Lock()
+		alertStates = original
+		alertStatesLock.Unlock()
+	})
+}
+
+func TestIsAlertSuppressedNoState(t *testing.T) {
+	withCleanAlertState(t)
+
+	if isAlertSuppressed("golang") {
+		t.Fatal("expected no suppression for a keyword with no recorded state")
+	}
+}
+
+func TestIsAlertSuppressedSnoozed(t *testing.T) {
+	withCleanAlertState(t)
+
+	alertStatesLock.Lock()
+	alertStates["golang"] = &AlertState{Keyword: "golang", SnoozedUntil: time.Now().Add(time.Hour)}
+	alertStatesLock.Unlock()
+
+	if !isAlertSuppressed("golang") {
+		t.Fatal("expected suppression while SnoozedUntil is in the future")
+	}
+}
+
+func TestIsAlertSuppressedSnoozeExpired(t *testing.T) {
+	withCleanAlertState(t)
+
+	alertStatesLock.Lock()
+	alertStates["golang"] = &AlertState{Keyword: "golang", SnoozedUntil: time.Now().Add(-time.Hour)}
+	alertStatesLock.Unlock()
+
+	if isAlertSuppressed("golang") {
+		t.Fatal("expected no suppression once SnoozedUntil has passed")
+	}
+}
+
+func TestIsAlertSuppressedRecentAck(t *testing.T) {
+	withCleanAlertState(t)
+
+	alertStatesLock.Lock()
+	alertStates["golang"] = &AlertState{Keyword: "golang", AckedBy: "alice", AckedAt: time.Now()}
+	alertStatesLock.Unlock()
+
+	if !isAlertSuppressed("golang") {
+		t.Fatal("expected a recent ack to suppress further notifications")
+	}
+}
+
+func TestIsAlertSuppressedAckExpires(t *testing.T) {
+	withCleanAlertState(t)
+
+	original := ackSuppressionWindow
+	ackSuppressionWindow = time.Minute
+	t.Cleanup(func() { ackSuppressionWindow = original })
+
+	alertStatesLock.Lock()
+	alertStates["golang"] = &AlertState{Keyword: "golang", AckedBy: "alice", AckedAt: time.Now().Add(-2 * time.Minute)}
+	alertStatesLock.Unlock()
+
+	if isAlertSuppressed("golang") {
+		t.Fatal("expected an ack older than ackSuppressionWindow to stop suppressing")
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// apiKeyPool round-robins across multiple API keys configured for one
+// provider (NewsAPI, YouTube), so a single key hitting its daily quota or
+// getting revoked doesn't take the whole source down. Fetchers call
+// Current to get a key, try the request, and call RotateOnError on
+// failure — if the error looks like a quota or auth problem
+// (classifySourceError, errors.go), the pool advances past that key so
+// the next attempt, in this call or the next one, uses a different key.
+type apiKeyPool struct {
+	name string
+
+	mu        sync.Mutex
+	keys      []string
+	index     int
+	usage     map[string]int
+	exhausted map[string]bool
+}
+
+// newAPIKeyPool builds a pool from a comma-separated list of keys.
+// Blank entries and surrounding whitespace are ignored, so a config that
+// only ever sets a single key still works unchanged.
+func newAPIKeyPool(name, commaSeparatedKeys string) *apiKeyPool {
+	var keys []string
+	for _, key := range strings.Split(commaSeparatedKeys, ",") {
+		key = strings.TrimSpace(key)
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return &apiKeyPool{
+		name:      name,
+		keys:      keys,
+		usage:     make(map[string]int),
+		exhausted: make(map[string]bool),
+	}
+}
+
+// Empty reports whether the pool has no configured keys.
+func (p *apiKeyPool) Empty() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.keys) == 0
+}
+
+// Current returns the pool's active key, recording a usage tick against
+// it, or "" if no keys are configured.
+func (p *apiKeyPool) Current() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.keys) == 0 {
+		return ""
+	}
+	key := p.keys[p.index%len(p.keys)]
+	p.usage[key]++
+	return key
+}
+
+// RotateOnError marks key exhausted and advances to the next
+// not-yet-exhausted key if err classifies as a quota or auth failure.
+// It reports whether callers should retry with the new Current() key.
+// If every key is exhausted, it resets them all so the pool keeps trying
+// rather than giving up permanently on a quota that resets daily.
+func (p *apiKeyPool) RotateOnError(key string, err error) bool {
+	classified := classifySourceError(err)
+	if !errors.Is(classified, ErrQuotaExceeded) && !errors.Is(classified, ErrAuth) {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.keys) <= 1 {
+		return false
+	}
+	p.exhausted[key] = true
+	p.index = (p.index + 1) % len(p.keys)
+
+	for i := 0; i < len(p.keys); i++ {
+		if !p.exhausted[p.keys[p.index]] {
+			return true
+		}
+		p.index = (p.index + 1) % len(p.keys)
+	}
+	p.exhausted = make(map[string]bool)
+	return true
+}
+
+// attempts caps how many keys RotateOnError-driven retry loops try before
+// giving up on one request.
+func (p *apiKeyPool) attempts() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.keys) == 0 {
+		return 1
+	}
+	return len(p.keys)
+}
+
+// snapshot reports per-key usage and exhaustion state for the admin
+// endpoint, masking each key to its last 4 characters so the response is
+// safe to share with anyone who can reach /admin.
+func (p *apiKeyPool) snapshot() []gin.H {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	rows := make([]gin.H, 0, len(p.keys))
+	for _, key := range p.keys {
+		rows = append(rows, gin.H{
+			"key":        maskAPIKey(key),
+			"usageCount": p.usage[key],
+			"exhausted":  p.exhausted[key],
+		})
+	}
+	return rows
+}
+
+func maskAPIKey(key string) string {
+	if len(key) <= 4 {
+		return "****"
+	}
+	return "****" + key[len(key)-4:]
+}
+
+var (
+	newsAPIKeyPool    *apiKeyPool
+	youtubeAPIKeyPool *apiKeyPool
+)
+
+// loadAPIKeyPools builds the NewsAPI and YouTube key pools from
+// NEWS_API_KEYS/YOUTUBE_API_KEYS if set, falling back to the single
+// NEWS_API_KEY/YOUTUBE_API_KEY value so an existing single-key deployment
+// keeps working unchanged.
+func loadAPIKeyPools() {
+	newsKeys := appConfig.NewsAPIKeys
+	if newsKeys == "" {
+		newsKeys = appConfig.NewsAPIKey
+	}
+	newsAPIKeyPool = newAPIKeyPool("NewsAPI", newsKeys)
+
+	youtubeKeys := appConfig.YouTubeAPIKeys
+	if youtubeKeys == "" {
+		youtubeKeys = appConfig.YouTubeAPIKey
+	}
+	youtubeAPIKeyPool = newAPIKeyPool("YouTube", youtubeKeys)
+}
+
+// apiKeyPoolStatusHandler serves GET /admin/api-keys with per-key usage
+// counts and exhaustion state for every configured key pool.
+func apiKeyPoolStatusHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"NewsAPI": newsAPIKeyPool.snapshot(),
+		"YouTube": youtubeAPIKeyPool.snapshot(),
+	})
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewAPIKeyPoolTrimsAndSkipsBlanks(t *testing.T) {
+	pool := newAPIKeyPool("Test", " key1 ,key2,, key3")
+	if pool.Empty() {
+		t.Fatal("expected a non-empty pool")
+	}
+	if got := len(pool.keys); got != 3 {
+		t.Fatalf("expected 3 keys, got %d: %v", got, pool.keys)
+	}
+}
+
+func TestAPIKeyPoolEmpty(t *testing.T) {
+	pool := newAPIKeyPool("Test", "")
+	if !pool.Empty() {
+		t.Fatal("expected an empty pool for an empty key list")
+	}
+	if got := pool.Current(); got != "" {
+		t.Fatalf("expected Current() to return \"\" for an empty pool, got %q", got)
+	}
+}
+
+func TestAPIKeyPoolCurrentRoundRobinsOnRotate(t *testing.T) {
+	pool := newAPIKeyPool("Test", "key1,key2,key3")
+
+	first := pool.Current()
+	if first != "key1" {
+		t.Fatalf("expected first key to be key1, got %q", first)
+	}
+
+	if retry := pool.RotateOnError(first, ErrQuotaExceeded); !retry {
+		t.Fatal("expected RotateOnError to report retry for a quota error with multiple keys")
+	}
+
+	second := pool.Current()
+	if second == first {
+		t.Fatalf("expected RotateOnError to advance past the exhausted key, still got %q", second)
+	}
+}
+
+func TestAPIKeyPoolRotateOnErrorIgnoresUnrelatedErrors(t *testing.T) {
+	pool := newAPIKeyPool("Test", "key1,key2")
+	first := pool.Current()
+
+	if retry := pool.RotateOnError(first, ErrSourceUnavailable); retry {
+		t.Fatal("expected RotateOnError to leave the pool alone for a non-quota, non-auth error")
+	}
+	if got := pool.Current(); got != first {
+		t.Fatalf("expected the active key to be unchanged, got %q want %q", got, first)
+	}
+}
+
+func TestAPIKeyPoolRotateOnErrorSingleKeyNeverRotates(t *testing.T) {
+	pool := newAPIKeyPool("Test", "onlykey")
+	if retry := pool.RotateOnError("onlykey", ErrQuotaExceeded); retry {
+		t.Fatal("expected RotateOnError to report no retry when there's only one key")
+	}
+}
+
+func TestAPIKeyPoolRotateOnErrorResetsWhenAllExhausted(t *testing.T) {
+	pool := newAPIKeyPool("Test", "key1,key2")
+	authErr := errors.New("401 unauthorized")
+
+	key1 := pool.Current()
+	pool.RotateOnError(key1, authErr)
+	key2 := pool.Current()
+	if retry := pool.RotateOnError(key2, authErr); !retry {
+		t.Fatal("expected RotateOnError to keep reporting retry even once every key is exhausted")
+	}
+
+	pool.mu.Lock()
+	exhaustedCount := len(pool.exhausted)
+	pool.mu.Unlock()
+	if exhaustedCount != 0 {
+		t.Fatalf("expected exhausted keys to be reset once every key has failed, got %d still marked", exhaustedCount)
+	}
+}
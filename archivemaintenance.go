@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// archiveMaintenanceInterval is how often the compaction job re-scans the
+// archive tier. Archival itself only happens hourly (retention.go), so
+// there's no need to compact more often than that.
+const archiveMaintenanceInterval = 6 * time.Hour
+
+// archiveMaintenanceStats reports the last compaction run's results via the
+// admin API, mirroring costReportHandler/bandwidthReportHandler's pattern
+// of exposing a background job's running totals rather than its raw state.
+type archiveMaintenanceStats struct {
+	LastRunAt        time.Time `json:"lastRunAt"`
+	FilesScanned     int       `json:"filesScanned"`
+	DuplicatesPruned int       `json:"duplicatesPruned"`
+	ArchiveBytes     int64     `json:"archiveBytes"`
+}
+
+var (
+	lastArchiveMaintenance     archiveMaintenanceStats
+	lastArchiveMaintenanceLock sync.Mutex
+)
+
+// startArchiveMaintenanceJob runs the periodic archive compaction/dedup
+// loop, the same pattern as startRetentionJob and startScheduledCrawler: a
+// goroutine ticking forever, safe to call once at startup.
+func startArchiveMaintenanceJob() {
+	go func() {
+		ticker := time.NewTicker(archiveMaintenanceInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			runArchiveCompaction()
+		}
+	}()
+}
+
+// runArchiveCompaction re-canonicalizes every archived keyword's results:
+// items that were only recognized as duplicates after being archived
+// separately (e.g. the same story crawled via NewsAPI one day and its
+// publisher's RSS feed the next, landing in different archive files) are
+// merged via dedupeNewsResults, the search index is rebuilt from the
+// deduped set, and the file is rewritten if anything changed.
+func runArchiveCompaction() {
+	entries, err := os.ReadDir(retentionConfig.ArchiveDir)
+	if err != nil {
+		return
+	}
+
+	stats := archiveMaintenanceStats{LastRunAt: time.Now()}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json.gz") {
+			continue
+		}
+		stats.FilesScanned++
+
+		key := strings.TrimSuffix(entry.Name(), ".json.gz")
+		path := filepath.Join(retentionConfig.ArchiveDir, entry.Name())
+
+		info, err := entry.Info()
+		if err == nil {
+			stats.ArchiveBytes += info.Size()
+		}
+
+		results, err := loadArchivedResults(key)
+		if err != nil {
+			slog.Error(fmt.Sprintf("Error reading archive %s during compaction: %s", path, err))
+			continue
+		}
+
+		deduped := dedupeNewsResults(results)
+		if len(deduped) == len(results) {
+			continue
+		}
+		stats.DuplicatesPruned += len(results) - len(deduped)
+
+		if err := archiveResults(key, deduped); err != nil {
+			slog.Error(fmt.Sprintf("Error rewriting compacted archive %s: %s", path, err))
+			continue
+		}
+		globalSearchIndex.Index(deduped)
+	}
+
+	lastArchiveMaintenanceLock.Lock()
+	lastArchiveMaintenance = stats
+	lastArchiveMaintenanceLock.Unlock()
+}
+
+// archiveStatsHandler serves GET /admin/archive/stats, the last compaction
+// run's totals.
+func archiveStatsHandler(c *gin.Context) {
+	lastArchiveMaintenanceLock.Lock()
+	stats := lastArchiveMaintenance
+	lastArchiveMaintenanceLock.Unlock()
+
+	c.JSON(http.StatusOK, stats)
+}
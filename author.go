@@ -0,0 +1,49 @@
+package main
+
+import "strings"
+
+// resultAuthor identifies who posted a result, when the source exposes
+// that — a tweet's author, a subreddit post's poster, a YouTube video's
+// channel. Sources that don't expose an identifiable author (RSS, NewsAPI)
+// leave this as the zero value.
+type resultAuthor struct {
+	Name       string `json:"name,omitempty"`
+	Handle     string `json:"handle,omitempty"`
+	ProfileURL string `json:"profileUrl,omitempty"`
+}
+
+// matchesAuthorFilter reports whether handle (as passed to ?author=) names
+// this result's author, matching on Handle first and falling back to Name
+// so a filter still works against sources that never fill in a real
+// @handle (e.g. Reddit usernames stored in Author.Name).
+func matchesAuthorFilter(author resultAuthor, handle string) bool {
+	handle = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(handle)), "@")
+	if handle == "" {
+		return true
+	}
+	if strings.ToLower(strings.TrimPrefix(author.Handle, "@")) == handle {
+		return true
+	}
+	return strings.ToLower(author.Name) == handle
+}
+
+// filterByAuthor narrows results to those whose Author matches handle. An
+// empty handle is a no-op, matching every other optional filter in this
+// codebase (filterBySentiment, filterByToxicity).
+func filterByAuthor(results map[string][]FeedResult, handle string) map[string][]FeedResult {
+	if strings.TrimSpace(handle) == "" {
+		return results
+	}
+
+	filtered := make(map[string][]FeedResult, len(results))
+	for source, feedResults := range results {
+		var kept []FeedResult
+		for _, result := range feedResults {
+			if matchesAuthorFilter(result.Author, handle) {
+				kept = append(kept, result)
+			}
+		}
+		filtered[source] = kept
+	}
+	return filtered
+}
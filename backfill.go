@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// backfillDefaultDays is how far back a backfill reaches when the caller
+// doesn't specify one.
+const backfillDefaultDays = 30
+
+// backfillMaxDays matches NewsAPI's developer-tier archive window; asking
+// for more than this just returns the same month of results NewsAPI would
+// give for the max anyway.
+const backfillMaxDays = 30
+
+// fetchNewsFeedsInRange is fetchNewsFeeds with an explicit from/to window
+// instead of "everything sorted by recency", so a newly watched keyword can
+// be backfilled with its recent history instead of starting from a blank
+// slate. Twitter, YouTube, RSS and Facebook have no archive search in this
+// codebase, so backfilling only ever draws from NewsAPI.
+func fetchNewsFeedsInRange(ctx context.Context, keyword string, from, to time.Time) []FeedResult {
+	apiKey := appConfig.NewsAPIKey
+	if apiKey == "" {
+		slog.Error(fmt.Sprintln("Error: NEWS_API_KEY environment variable is not set"))
+		return nil
+	}
+
+	plan := compileQueryPlan(keyword)
+	baseURL := "https://newsapi.org/v2/everything"
+	query := url.QueryEscape(plan.NewsAPIQuery)
+	urlStr := fmt.Sprintf("%s?q=%s&language=en&sortBy=publishedAt&from=%s&to=%s&apiKey=%s",
+		baseURL, query, from.Format("2006-01-02"), to.Format("2006-01-02"), apiKey)
+
+	slog.Info(fmt.Sprintf("Backfilling news feed from URL: %s", urlStr))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error building backfill request: %s", err))
+		return nil
+	}
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error fetching backfill feed: %s", err))
+		return nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err := parseNewsAPIError(resp)
+		slog.Error(fmt.Sprintf("Error fetching backfill feed: %s", err))
+		recordSourceError("NewsAPI", err)
+		return nil
+	}
+	recordSourceError("NewsAPI", nil)
+
+	var apiResponse struct {
+		Articles []struct {
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			URL         string `json:"url"`
+			PublishedAt string `json:"publishedAt"`
+			Source      struct {
+				Name string `json:"name"`
+			} `json:"source"`
+			URLToImage string `json:"urlToImage"`
+		} `json:"articles"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding backfill response: %s", err))
+		return nil
+	}
+
+	slog.Info(fmt.Sprintf("Backfill returned %d articles for %q", len(apiResponse.Articles), keyword))
+	recordCost("newsapi_call", keyword, 1)
+
+	var results []FeedResult
+	for _, article := range apiResponse.Articles {
+		published, _ := time.Parse(time.RFC3339, article.PublishedAt)
+		results = append(results, FeedResult{
+			Title:         article.Title,
+			Link:          article.URL,
+			Published:     published.Format("2006-01-02 15:04:05"),
+			PublishedTime: published,
+			Description:   article.Description,
+			Source:        article.Source.Name,
+			Thumbnail:     article.URLToImage,
+		})
+	}
+	return results
+}
+
+// runBackfillForKeyword fetches keyword's last days of NewsAPI coverage and
+// files it into search history bucketed by day, so a volume series built
+// from history entries has real data for a newly watched keyword instead
+// of only starting from the moment it was first searched.
+func runBackfillForKeyword(ctx context.Context, keyword string, days int) (int, error) {
+	if days <= 0 {
+		days = backfillDefaultDays
+	}
+	if days > backfillMaxDays {
+		days = backfillMaxDays
+	}
+
+	now := time.Now()
+	articles := annotateStableIDs("NewsAPI", annotateTextMetadata(fetchNewsFeedsInRange(ctx, keyword, now.AddDate(0, 0, -days), now)))
+
+	byDay := make(map[string][]FeedResult)
+	for _, article := range articles {
+		day := article.PublishedTime.Format("2006-01-02")
+		byDay[day] = append(byDay[day], article)
+	}
+
+	appDBLock.Lock()
+	if appDB.History == nil {
+		appDB.History = make(map[string][]historyEntry)
+	}
+	existingDays := make(map[string]bool)
+	for _, entry := range appDB.History[keyword] {
+		existingDays[entry.CrawledAt.Format("2006-01-02")] = true
+	}
+
+	inserted := 0
+	for day, dayResults := range byDay {
+		if existingDays[day] {
+			continue
+		}
+		crawledAt, err := time.Parse("2006-01-02", day)
+		if err != nil {
+			continue
+		}
+		appDB.History[keyword] = append(appDB.History[keyword], historyEntry{CrawledAt: crawledAt, Results: dayResults})
+		inserted++
+	}
+	sort.Slice(appDB.History[keyword], func(i, j int) bool {
+		return appDB.History[keyword][i].CrawledAt.Before(appDB.History[keyword][j].CrawledAt)
+	})
+	appDBLock.Unlock()
+
+	if inserted == 0 {
+		return 0, nil
+	}
+	return inserted, saveAppDB()
+}
+
+// backfillKeywordHandler serves POST /watchlist/:keyword/backfill?days=N.
+func backfillKeywordHandler(c *gin.Context) {
+	keyword := c.Param("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is required"})
+		return
+	}
+	if rejectSearchInPublicMode(c, keyword) {
+		return
+	}
+
+	days := backfillDefaultDays
+	if raw := c.Query("days"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "days must be a positive integer"})
+			return
+		}
+		days = parsed
+	}
+
+	inserted, err := runBackfillForKeyword(c.Request.Context(), keyword, days)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"keyword": keyword, "daysInserted": inserted})
+}
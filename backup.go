@@ -0,0 +1,112 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+)
+
+// stateFiles lists every file that makes up the application's persisted
+// state. Backup and restore operate on exactly this set, so a new state
+// file must be added here to be included in future backups.
+var stateFiles = []string{
+	"searched_keywords.json",
+	"twitterhandles.json",
+	"news_sources.json",
+	"alert_states.json",
+}
+
+// runBackupCommand writes every state file into a single tar.gz archive so
+// migrating hosts doesn't mean hand-copying JSON files one at a time.
+func runBackupCommand(destPath string) error {
+	out, err := os.Create(destPath)
+	if err != nil {
+		return fmt.Errorf("error creating backup archive: %w", err)
+	}
+	defer out.Close()
+
+	gz := gzip.NewWriter(out)
+	defer gz.Close()
+
+	tw := tar.NewWriter(gz)
+	defer tw.Close()
+
+	for _, name := range stateFiles {
+		if err := addFileToArchive(tw, name); err != nil {
+			slog.Info(fmt.Sprintf("Skipping %s in backup: %s", name, err))
+			continue
+		}
+	}
+
+	slog.Info(fmt.Sprintf("Backup written to %s", destPath))
+	return nil
+}
+
+func addFileToArchive(tw *tar.Writer, name string) error {
+	file, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	header.Name = name
+
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, file)
+	return err
+}
+
+// runRestoreCommand extracts a backup archive created by runBackupCommand,
+// overwriting the current state files.
+func runRestoreCommand(srcPath string) error {
+	in, err := os.Open(srcPath)
+	if err != nil {
+		return fmt.Errorf("error opening backup archive: %w", err)
+	}
+	defer in.Close()
+
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		return fmt.Errorf("error reading backup archive: %w", err)
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error reading backup entry: %w", err)
+		}
+
+		out, err := os.Create(header.Name)
+		if err != nil {
+			return fmt.Errorf("error restoring %s: %w", header.Name, err)
+		}
+		if _, err := io.Copy(out, tr); err != nil {
+			out.Close()
+			return fmt.Errorf("error writing %s: %w", header.Name, err)
+		}
+		out.Close()
+		slog.Info(fmt.Sprintf("Restored %s", header.Name))
+	}
+
+	slog.Info(fmt.Sprintf("Restore complete from %s", srcPath))
+	return nil
+}
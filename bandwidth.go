@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxResponseBytes caps how much of any single response body we'll read.
+// A misconfigured feed once returned a 300MB response and the process
+// ballooned; overridable via MAX_RESPONSE_BYTES for sources that
+// legitimately need more.
+var maxResponseBytes = loadMaxResponseBytes()
+
+const defaultMaxResponseBytes = 20 * 1024 * 1024 // 20MB
+
+func loadMaxResponseBytes() int64 {
+	if raw := os.Getenv("MAX_RESPONSE_BYTES"); raw != "" {
+		if parsed, err := strconv.ParseInt(raw, 10, 64); err == nil && parsed > 0 {
+			return parsed
+		}
+	}
+	return defaultMaxResponseBytes
+}
+
+// bandwidthByHost tracks cumulative response bytes read per host, so an
+// operator can see which source is heaviest without instrumenting each
+// fetcher individually.
+var (
+	bandwidthByHost   = make(map[string]int64)
+	bandwidthByHostMu sync.Mutex
+)
+
+func recordBandwidth(host string, bytes int64) {
+	bandwidthByHostMu.Lock()
+	defer bandwidthByHostMu.Unlock()
+	bandwidthByHost[host] += bytes
+}
+
+func snapshotBandwidth() map[string]int64 {
+	bandwidthByHostMu.Lock()
+	defer bandwidthByHostMu.Unlock()
+	snapshot := make(map[string]int64, len(bandwidthByHost))
+	for host, bytes := range bandwidthByHost {
+		snapshot[host] = bytes
+	}
+	return snapshot
+}
+
+// limitingRoundTripper enforces maxResponseBytes on every response body and
+// records per-host bandwidth usage as the body is consumed.
+type limitingRoundTripper struct {
+	Base http.RoundTripper
+}
+
+func (t *limitingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = &limitedBandwidthBody{
+		host:   req.URL.Host,
+		reader: io.LimitReader(resp.Body, maxResponseBytes+1),
+		closer: resp.Body,
+		budget: maxResponseBytes,
+	}
+	return resp, nil
+}
+
+// limitedBandwidthBody wraps a response body so reading past
+// maxResponseBytes returns an error instead of silently continuing, and so
+// bytes actually consumed are accounted to the source host once closed.
+type limitedBandwidthBody struct {
+	host   string
+	reader io.Reader
+	closer io.Closer
+	budget int64
+	read   int64
+}
+
+func (b *limitedBandwidthBody) Read(p []byte) (int, error) {
+	n, err := b.reader.Read(p)
+	b.read += int64(n)
+	if b.read > b.budget {
+		return n, fmt.Errorf("response from %s exceeded %d byte limit", b.host, b.budget)
+	}
+	return n, err
+}
+
+func (b *limitedBandwidthBody) Close() error {
+	recordBandwidth(b.host, b.read)
+	return b.closer.Close()
+}
+
+func bandwidthReportHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"bytesByHost": snapshotBandwidth()})
+}
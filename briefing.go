@@ -0,0 +1,134 @@
+package main
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// briefingSocialSources lists which FeedResult.Source values count as
+// "notable social posts" rather than news coverage, for the briefing's
+// social-vs-news split. RSS/NewsAPI/GoogleNews are the news-style sources
+// registered elsewhere in this codebase; everything else is social.
+var briefingNewsSources = map[string]bool{
+	"RSS": true, "NewsAPI": true, "GoogleNews": true,
+}
+
+// briefingTopStoriesLimit and briefingSocialPostsLimit bound how much a
+// briefing response includes of each section, the same "top N" shape
+// clusterDetailHandler and the QA context window (qa.go) use to keep a
+// response bounded regardless of how much history a project has.
+const (
+	briefingTopStoriesLimit  = 5
+	briefingSocialPostsLimit = 5
+)
+
+// projectBriefing is the response for GET /api/v1/briefing.
+type projectBriefing struct {
+	Project            string       `json:"project"`
+	TopStories         []FeedResult `json:"topStories"`
+	NotableSocialPosts []FeedResult `json:"notableSocialPosts"`
+	SentimentShift     float64      `json:"sentimentShift"`
+	VolumeChangePct    float64      `json:"volumeChangePct"`
+	TodayCount         int          `json:"todayCount"`
+	YesterdayCount     int          `json:"yesterdayCount"`
+}
+
+// briefingHandler composes GET /api/v1/briefing?project=<keyword> from
+// this codebase's existing pieces rather than adding a new pipeline:
+// ranking reuses annotateVelocity (velocity.go), the news/social split
+// reuses FeedResult.Source, and sentiment reuses the enrichment.go
+// Sentiment field already computed at fetch time. "project" is treated as
+// a watched keyword, the same identifier every other per-topic endpoint in
+// this codebase (history, watchlist, summary) keys off of.
+func briefingHandler(c *gin.Context) {
+	project := c.Query("project")
+	if project == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "project is required"})
+		return
+	}
+
+	appDBLock.Lock()
+	entries := appDB.History[project]
+	appDBLock.Unlock()
+	if len(entries) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no stored results for this project"})
+		return
+	}
+
+	now := time.Now()
+	var today, yesterday []FeedResult
+	for _, entry := range entries {
+		age := now.Sub(entry.CrawledAt)
+		switch {
+		case age <= 24*time.Hour:
+			today = append(today, entry.Results...)
+		case age <= 48*time.Hour:
+			yesterday = append(yesterday, entry.Results...)
+		}
+	}
+
+	topStories := rankTopStories(today, briefingTopStoriesLimit)
+	socialPosts := rankNotableSocialPosts(today, briefingSocialPostsLimit)
+
+	briefing := projectBriefing{
+		Project:            project,
+		TopStories:         topStories,
+		NotableSocialPosts: socialPosts,
+		SentimentShift:     averageSentiment(today) - averageSentiment(yesterday),
+		VolumeChangePct:    volumeChangePercent(len(today), len(yesterday)),
+		TodayCount:         len(today),
+		YesterdayCount:     len(yesterday),
+	}
+	c.JSON(http.StatusOK, briefing)
+}
+
+// rankTopStories ranks results by velocity (annotateVelocity, velocity.go)
+// so a story getting picked up across many sources outranks a single
+// isolated mention, then takes the top limit.
+func rankTopStories(results []FeedResult, limit int) []FeedResult {
+	ranked := annotateVelocity(append([]FeedResult(nil), results...))
+	sort.Slice(ranked, func(i, j int) bool { return ranked[i].Velocity > ranked[j].Velocity })
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	return ranked
+}
+
+// rankNotableSocialPosts keeps only non-news sources, most recent first.
+func rankNotableSocialPosts(results []FeedResult, limit int) []FeedResult {
+	var social []FeedResult
+	for _, result := range results {
+		if !briefingNewsSources[result.Source] {
+			social = append(social, result)
+		}
+	}
+	sort.Slice(social, func(i, j int) bool { return social[i].PublishedTime.After(social[j].PublishedTime) })
+	if len(social) > limit {
+		social = social[:limit]
+	}
+	return social
+}
+
+func averageSentiment(results []FeedResult) float64 {
+	if len(results) == 0 {
+		return 0
+	}
+	var total float64
+	for _, result := range results {
+		total += result.Sentiment
+	}
+	return total / float64(len(results))
+}
+
+func volumeChangePercent(today, yesterday int) float64 {
+	if yesterday == 0 {
+		if today == 0 {
+			return 0
+		}
+		return 100
+	}
+	return (float64(today) - float64(yesterday)) / float64(yesterday) * 100
+}
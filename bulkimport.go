@@ -0,0 +1,193 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// csvImportRowResult reports the outcome of importing a single CSV row, so
+// onboarding a new vertical's worth of sources shows exactly which rows
+// were bad instead of failing (or silently accepting) the whole file.
+type csvImportRowResult struct {
+	Row   int    `json:"row"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// importSourcesFromCSV reads a "type,value" CSV (type is "rss" or
+// "twitter", header row required) and appends valid rows to
+// news_sources.json / twitterhandles.json, returning a per-row result so
+// bad rows don't block the good ones.
+func importSourcesFromCSV(r io.Reader) ([]csvImportRowResult, error) {
+	reader := csv.NewReader(r)
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing CSV: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("CSV file is empty")
+	}
+
+	var newRSSSources []string
+	var newTwitterHandles []string
+	var results []csvImportRowResult
+
+	for i, row := range rows[1:] { // skip header
+		rowNum := i + 2 // 1-indexed, plus the header row
+		if len(row) < 2 {
+			results = append(results, csvImportRowResult{Row: rowNum, OK: false, Error: "expected 2 columns: type,value"})
+			continue
+		}
+
+		sourceType := strings.ToLower(strings.TrimSpace(row[0]))
+		value := strings.TrimSpace(row[1])
+		result := csvImportRowResult{Row: rowNum, Type: sourceType, Value: value}
+
+		switch sourceType {
+		case "rss":
+			if err := validateRSSSourceURL(value); err != nil {
+				result.Error = err.Error()
+			} else {
+				result.OK = true
+				newRSSSources = append(newRSSSources, value)
+			}
+		case "twitter":
+			if err := validateTwitterHandle(value); err != nil {
+				result.Error = err.Error()
+			} else {
+				result.OK = true
+				newTwitterHandles = append(newTwitterHandles, value)
+			}
+		default:
+			result.Error = fmt.Sprintf("unknown type %q, expected rss or twitter", sourceType)
+		}
+
+		results = append(results, result)
+	}
+
+	if len(newRSSSources) > 0 {
+		if err := appendNewsSources(newRSSSources); err != nil {
+			return results, fmt.Errorf("error saving imported RSS sources: %w", err)
+		}
+	}
+	if len(newTwitterHandles) > 0 {
+		if err := appendTwitterHandles(newTwitterHandles); err != nil {
+			return results, fmt.Errorf("error saving imported Twitter handles: %w", err)
+		}
+	}
+
+	return results, nil
+}
+
+func validateRSSSourceURL(raw string) error {
+	if raw == "" {
+		return fmt.Errorf("URL is empty")
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("not a valid http(s) URL")
+	}
+	return nil
+}
+
+func validateTwitterHandle(handle string) error {
+	handle = strings.TrimPrefix(handle, "@")
+	if handle == "" {
+		return fmt.Errorf("handle is empty")
+	}
+	for _, r := range handle {
+		if !(r >= 'a' && r <= 'z') && !(r >= 'A' && r <= 'Z') && !(r >= '0' && r <= '9') && r != '_' {
+			return fmt.Errorf("handle contains invalid character %q", r)
+		}
+	}
+	return nil
+}
+
+func appendNewsSources(sources []string) error {
+	existing, err := loadNewsSourceConfigs("news_sources.json")
+	if err != nil {
+		return err
+	}
+	for _, source := range sources {
+		existing = append(existing, RSSSourceConfig{URL: source})
+	}
+	return saveNewsSourceConfigs("news_sources.json", existing)
+}
+
+func appendTwitterHandles(handles []string) error {
+	existing := loadTwitterHandlesSafe("twitterhandles.json")
+	file, err := os.Create("twitterhandles.json")
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "    ")
+	return encoder.Encode(struct {
+		Handles []string `json:"handles"`
+	}{Handles: append(existing, handles...)})
+}
+
+// runImportCSVCommand is the CLI entry point: socialmediaaggregator import-csv <file.csv>.
+func runImportCSVCommand(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening CSV file: %w", err)
+	}
+	defer file.Close()
+
+	results, err := importSourcesFromCSV(file)
+	if err != nil {
+		return err
+	}
+
+	var okCount int
+	for _, result := range results {
+		if result.OK {
+			okCount++
+		} else {
+			fmt.Printf("row %d: %s\n", result.Row, result.Error)
+		}
+	}
+	fmt.Printf("Imported %d/%d rows\n", okCount, len(results))
+	return nil
+}
+
+// importSourcesHandler accepts a multipart-uploaded CSV at
+// POST /admin/sources/import.
+func importSourcesHandler(c *gin.Context) {
+	if !requireUnrestricted(c) {
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "error opening uploaded file"})
+		return
+	}
+	defer file.Close()
+
+	results, err := importSourcesFromCSV(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "results": results})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": results})
+}
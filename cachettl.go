@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// cacheTTLBySource is how long a cache entry is considered fresh before a
+// background refresh is triggered. Different sources refresh at different
+// rates: YouTube quota is expensive, so it's cached longer than news.
+var cacheTTLBySource = map[string]time.Duration{
+	"news":    10 * time.Minute,
+	"youtube": 30 * time.Minute,
+}
+
+// maxCacheEntries bounds the cache size; once exceeded, the oldest entry
+// (by insertion order) is evicted.
+const maxCacheEntries = 500
+
+var (
+	cacheOrder     []string
+	cacheOrderLock sync.Mutex
+
+	refreshInFlight sync.Map // key -> struct{}, prevents duplicate concurrent refreshes
+)
+
+// trackCacheInsert records a cache key's insertion order for LRU-style
+// eviction and evicts the oldest entry once the cache grows past
+// maxCacheEntries.
+func trackCacheInsert(key string) {
+	cacheOrderLock.Lock()
+	defer cacheOrderLock.Unlock()
+
+	cacheOrder = append(cacheOrder, key)
+	if len(cacheOrder) <= maxCacheEntries {
+		return
+	}
+
+	oldest := cacheOrder[0]
+	cacheOrder = cacheOrder[1:]
+	cache.Delete(oldest)
+	cacheStoredAtLock.Lock()
+	delete(cacheStoredAt, oldest)
+	cacheStoredAtLock.Unlock()
+	slog.Info(fmt.Sprintf("Cache evicted oldest entry %s (max %d entries)", oldest, maxCacheEntries))
+}
+
+// isCacheEntryStale reports whether a cache entry has outlived its
+// source-specific TTL.
+func isCacheEntryStale(key, sourcePrefix string) bool {
+	ttl, ok := cacheTTLBySource[sourcePrefix]
+	if !ok {
+		return false
+	}
+
+	cacheStoredAtLock.Lock()
+	storedAt, ok := cacheStoredAt[key]
+	cacheStoredAtLock.Unlock()
+	if !ok {
+		return true
+	}
+	return time.Since(storedAt) > ttl
+}
+
+// refreshCacheInBackground re-fetches a key exactly once even if several
+// requests notice it's stale at the same time (stale-while-revalidate).
+func refreshCacheInBackground(key string, refresh func()) {
+	if _, alreadyRefreshing := refreshInFlight.LoadOrStore(key, struct{}{}); alreadyRefreshing {
+		return
+	}
+	go func() {
+		defer refreshInFlight.Delete(key)
+		refresh()
+	}()
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/transform"
+)
+
+// gofeed already decodes feeds using their declared XML encoding, but some
+// feeds lie about their charset in the <?xml?> declaration (ISO-8859-1 and
+// Windows-1251 are the recurring offenders), so their titles and
+// descriptions come through as mojibake even after gofeed's own decoding.
+// normalizeFeedText re-checks the result and re-decodes it against the most
+// common mislabeled encodings when it isn't valid UTF-8.
+var fallbackEncodings = []encoding.Encoding{
+	charmap.ISO8859_1,
+	charmap.Windows1251,
+	charmap.Windows1252,
+}
+
+func normalizeFeedText(text string) string {
+	if utf8.ValidString(text) {
+		return text
+	}
+
+	for _, enc := range fallbackEncodings {
+		if decoded, ok := tryDecode(text, enc); ok {
+			return decoded
+		}
+	}
+
+	return utf8Sanitize(text)
+}
+
+func tryDecode(text string, enc encoding.Encoding) (string, bool) {
+	decoded, _, err := transform.String(enc.NewDecoder(), text)
+	if err != nil || !utf8.ValidString(decoded) {
+		return "", false
+	}
+	return decoded, true
+}
+
+// utf8Sanitize is the last resort when no fallback encoding produces valid
+// UTF-8: replace invalid byte sequences rather than let them propagate into
+// templates and JSON responses.
+func utf8Sanitize(text string) string {
+	if utf8.ValidString(text) {
+		return text
+	}
+	valid := make([]rune, 0, len(text))
+	for i, r := range text {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(text[i:]); size == 1 {
+				continue
+			}
+		}
+		valid = append(valid, r)
+	}
+	return string(valid)
+}
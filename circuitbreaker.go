@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// circuitBreakerFailureThreshold is how many consecutive failures from a
+// source trip its breaker open, overridable via
+// CIRCUIT_BREAKER_FAILURE_THRESHOLD.
+var circuitBreakerFailureThreshold = loadRetryIntEnv("CIRCUIT_BREAKER_FAILURE_THRESHOLD", 3)
+
+// circuitBreakerCooldown is how long a tripped breaker stays open before
+// allowing one trial request through, overridable via
+// CIRCUIT_BREAKER_COOLDOWN_SECONDS.
+var circuitBreakerCooldown = loadCircuitBreakerCooldown()
+
+const defaultCircuitBreakerCooldown = 60 * time.Second
+
+func loadCircuitBreakerCooldown() time.Duration {
+	if raw := os.Getenv("CIRCUIT_BREAKER_COOLDOWN_SECONDS"); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			return time.Duration(n) * time.Second
+		}
+	}
+	return defaultCircuitBreakerCooldown
+}
+
+// sourceCircuitBreaker tracks one FeedSource's recent reliability, so a
+// source that's repeatedly failing (Twitter scraping getting blocked,
+// NewsAPI down) is skipped for a cool-down window instead of every search
+// paying its full timeout again.
+type sourceCircuitBreaker struct {
+	consecutiveFailures int
+	openedAt            time.Time
+}
+
+var (
+	circuitBreakers     = make(map[string]*sourceCircuitBreaker)
+	circuitBreakersLock sync.Mutex
+)
+
+// circuitOpen reports whether source's breaker is currently open (tripped
+// and still within its cool-down window). A breaker past its cool-down is
+// treated as closed here so the next fetchSource call gets a trial request;
+// recordSourceOutcome reopens it immediately if that trial also fails.
+func circuitOpen(source string) bool {
+	circuitBreakersLock.Lock()
+	defer circuitBreakersLock.Unlock()
+
+	breaker, ok := circuitBreakers[source]
+	if !ok || breaker.consecutiveFailures < circuitBreakerFailureThreshold {
+		return false
+	}
+	return time.Since(breaker.openedAt) < circuitBreakerCooldown
+}
+
+// recordSourceOutcome updates source's breaker after a fetch attempt. The
+// first failure that crosses circuitBreakerFailureThreshold trips the
+// breaker and starts its cool-down clock; any success resets it.
+func recordSourceOutcome(source string, err error) {
+	circuitBreakersLock.Lock()
+	defer circuitBreakersLock.Unlock()
+
+	breaker, ok := circuitBreakers[source]
+	if !ok {
+		breaker = &sourceCircuitBreaker{}
+		circuitBreakers[source] = breaker
+	}
+
+	if err == nil {
+		breaker.consecutiveFailures = 0
+		return
+	}
+
+	breaker.consecutiveFailures++
+	if breaker.consecutiveFailures >= circuitBreakerFailureThreshold {
+		breaker.openedAt = time.Now()
+	}
+}
+
+// circuitBreakerSnapshot is a read-only copy of one source's breaker state,
+// for status reporting (statuspage.go) that shouldn't hold
+// circuitBreakersLock while it works.
+type circuitBreakerSnapshot struct {
+	ConsecutiveFailures int
+	Open                bool
+}
+
+// snapshotCircuitBreakers returns every source's current breaker state.
+func snapshotCircuitBreakers() map[string]circuitBreakerSnapshot {
+	circuitBreakersLock.Lock()
+	defer circuitBreakersLock.Unlock()
+
+	snapshot := make(map[string]circuitBreakerSnapshot, len(circuitBreakers))
+	for source, breaker := range circuitBreakers {
+		open := breaker.consecutiveFailures >= circuitBreakerFailureThreshold &&
+			time.Since(breaker.openedAt) < circuitBreakerCooldown
+		snapshot[source] = circuitBreakerSnapshot{
+			ConsecutiveFailures: breaker.consecutiveFailures,
+			Open:                open,
+		}
+	}
+	return snapshot
+}
+
+// lastGoodResults caches the most recent successful results per
+// source+keyword, so a search that hits an open breaker can still show
+// something instead of an empty section.
+var (
+	lastGoodResults     = make(map[string]map[string][]FeedResult)
+	lastGoodResultsLock sync.Mutex
+)
+
+func recordLastGoodResults(source, keyword string, results []FeedResult) {
+	lastGoodResultsLock.Lock()
+	defer lastGoodResultsLock.Unlock()
+
+	if lastGoodResults[source] == nil {
+		lastGoodResults[source] = make(map[string][]FeedResult)
+	}
+	lastGoodResults[source][keyword] = results
+}
+
+func cachedResultsFor(source, keyword string) []FeedResult {
+	lastGoodResultsLock.Lock()
+	defer lastGoodResultsLock.Unlock()
+
+	return lastGoodResults[source][keyword]
+}
+
+// sourceLastSuccessAt tracks when each source last completed a fetch
+// without error, for status reporting (statuspage.go): "no Twitter
+// results" reads very differently depending on whether Twitter last
+// succeeded a minute ago or three days ago.
+var (
+	sourceLastSuccessAt     = make(map[string]time.Time)
+	sourceLastSuccessAtLock sync.Mutex
+)
+
+func recordSourceSuccessTime(source string) {
+	sourceLastSuccessAtLock.Lock()
+	defer sourceLastSuccessAtLock.Unlock()
+	sourceLastSuccessAt[source] = time.Now()
+}
+
+func snapshotSourceLastSuccessAt() map[string]time.Time {
+	sourceLastSuccessAtLock.Lock()
+	defer sourceLastSuccessAtLock.Unlock()
+
+	snapshot := make(map[string]time.Time, len(sourceLastSuccessAt))
+	for source, at := range sourceLastSuccessAt {
+		snapshot[source] = at
+	}
+	return snapshot
+}
+
+// errCircuitOpen is recorded as source's error when its breaker is open, so
+// snapshotSourceErrors distinguishes "skipped, breaker open" from an actual
+// fetch failure.
+func errCircuitOpen(source string) error {
+	return fmt.Errorf("circuit breaker open for %s, skipping until cool-down elapses", source)
+}
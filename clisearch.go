@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// runSearchCommand implements `aggressor search <keyword> [--sources=a,b,c]
+// [--format=table|json]`: a headless equivalent of a browser search that
+// calls the same fetchAllFeeds every web request uses, then prints the
+// results to stdout instead of rendering a template, for scripting and cron
+// usage where starting the Gin server would be pointless.
+func runSearchCommand(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("usage: aggressor search <keyword> [--sources=a,b,c] [--format=table|json]")
+	}
+
+	keyword := args[0]
+	format := "table"
+	var sources []string
+
+	for _, arg := range args[1:] {
+		switch {
+		case strings.HasPrefix(arg, "--format="):
+			format = strings.TrimPrefix(arg, "--format=")
+		case strings.HasPrefix(arg, "--sources="):
+			sources = strings.Split(strings.TrimPrefix(arg, "--sources="), ",")
+		default:
+			return fmt.Errorf("unrecognized flag %q", arg)
+		}
+	}
+	if format != "table" && format != "json" {
+		return fmt.Errorf("unsupported --format %q, want table or json", format)
+	}
+
+	if sources != nil {
+		restore := restrictEnabledSources(sources)
+		defer restore()
+	}
+
+	results := fetchAllFeeds(context.Background(), keyword)
+
+	if format == "json" {
+		return json.NewEncoder(os.Stdout).Encode(results)
+	}
+	printSearchResultsTable(results)
+	return nil
+}
+
+// restrictEnabledSources disables every registered source not named in
+// names for the duration of one CLI search, restoring every source's prior
+// enabled state via the returned func. Matching is case-insensitive against
+// FeedSource.Name() so `--sources=news,youtube` works the same as
+// `--sources=NewsAPI,YouTube` would be tedious to require.
+func restrictEnabledSources(names []string) (restore func()) {
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+
+	previous := make(map[string]bool, len(sourceRegistryOrder))
+	for _, name := range sourceRegistryOrder {
+		previous[name] = isSourceEnabled(name)
+		setSourceEnabled(name, wanted[strings.ToLower(name)])
+	}
+
+	return func() {
+		for name, enabled := range previous {
+			setSourceEnabled(name, enabled)
+		}
+	}
+}
+
+// printSearchResultsTable renders search results as a plain aligned table,
+// one row per result, grouped by source.
+func printSearchResultsTable(results map[string][]FeedResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "SOURCE\tTITLE\tLINK")
+	for _, name := range sourceRegistryOrder {
+		for _, result := range results[name] {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", name, result.Title, result.Link)
+		}
+	}
+}
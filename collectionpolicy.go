@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+)
+
+// collectionPolicyFile is where an operator declares which collection
+// categories (feedsource.go's collectionCategory) their deployment is
+// legally comfortable with — e.g. an operator with no scraping exemption
+// can forbid categoryScraper and know Facebook's chromedp source can never
+// be switched back on, even by an admin API call.
+const collectionPolicyFile = "collection_policy.json"
+
+// CollectionPolicy is the on-disk shape of collection_policy.json. A nil
+// AllowedCategories (the default, no file present) means no restriction:
+// existing deployments see no behavior change until they opt in.
+type CollectionPolicy struct {
+	AllowedCategories []string `json:"allowedCategories"`
+}
+
+var collectionPolicy CollectionPolicy
+
+func loadCollectionPolicy() {
+	file, err := os.Open(collectionPolicyFile)
+	if err != nil {
+		slog.Info(fmt.Sprintf("No collection policy found, all source categories permitted: %s", err))
+		return
+	}
+	defer file.Close()
+
+	var policy CollectionPolicy
+	if err := json.NewDecoder(file).Decode(&policy); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding collection policy, all source categories permitted: %s", err))
+		return
+	}
+	collectionPolicy = policy
+
+	// A freshly loaded policy may forbid a category some source is already
+	// enabled under (registerSource ran before this loads at startup), so
+	// sweep the registry once to bring it into compliance immediately.
+	for _, name := range sourceRegistryOrder {
+		if isSourceEnabled(name) && !collectionAllowed(sourceCategories[name]) {
+			slog.Warn(fmt.Sprintf("Disabling source %s: its %s collection category is forbidden by the active collection policy", name, sourceCategories[name]))
+			sourceEnabled[name] = false
+		}
+	}
+}
+
+// collectionAllowed reports whether category may be enabled under the
+// active policy. An empty AllowedCategories list (no policy loaded) permits
+// everything.
+func collectionAllowed(category collectionCategory) bool {
+	if len(collectionPolicy.AllowedCategories) == 0 {
+		return true
+	}
+	for _, allowed := range collectionPolicy.AllowedCategories {
+		if collectionCategory(allowed) == category {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// Config holds every credential the aggregator needs, loaded from
+// environment variables so no API key ever needs to be hardcoded or
+// committed to the repo.
+type Config struct {
+	NewsAPIKey         string
+	YouTubeAPIKey      string
+	TwitterBearerToken string
+	// NewsAPIKeys and YouTubeAPIKeys, if set, are a comma-separated pool
+	// of keys (apikeypool.go) that rotate on a 401/403/429 response
+	// instead of relying on a single key. Left empty, NewsAPIKey/
+	// YouTubeAPIKey above is used as a one-key pool.
+	NewsAPIKeys    string
+	YouTubeAPIKeys string
+	// LLM* configure the optional question-answering backend (see qa.go).
+	// Unlike the keys above, an empty LLMAPIKey just leaves that feature
+	// disabled rather than making the whole process fail to start.
+	LLMAPIKey     string
+	LLMAPIBaseURL string
+	LLMModel      string
+	// SentimentAPIURL, if set, switches sentiment enrichment (enrichment.go)
+	// from the local lexicon to this external endpoint.
+	SentimentAPIURL string
+	SentimentAPIKey string
+	// ToxicityAPIURL, if set, switches aggression enrichment
+	// (aggression.go) from the local lexicon to this external endpoint
+	// (e.g. a Perspective API-compatible service).
+	ToxicityAPIURL string
+	ToxicityAPIKey string
+	// WebhookSecret authenticates POST /webhooks/crawl (webhook.go). Left
+	// empty, that endpoint is disabled rather than accepting unauthenticated
+	// crawl triggers.
+	WebhookSecret string
+	// InstagramAccessToken and InstagramBusinessAccountID authenticate the
+	// Graph API hashtag search in instagram.go. Left empty, Instagram falls
+	// back to the chromedp scraper instead of failing to start.
+	InstagramAccessToken       string
+	InstagramBusinessAccountID string
+	// InstagramWebhookVerifyToken authenticates Meta's mentions webhook
+	// subscription handshake and callbacks in instagram.go. Left empty,
+	// that endpoint refuses every verification attempt.
+	InstagramWebhookVerifyToken string
+	// FacebookAppToken authenticates the Graph API page/post search in
+	// facebookgraph.go. Left empty, Facebook returns nothing unless
+	// FACEBOOK_ENABLE_SCRAPE_FALLBACK=1 opts into the chromedp scraper.
+	FacebookAppToken string
+	// LinkedInSessionCookie, if set, is attached as the li_at cookie before
+	// scraping LinkedIn (linkedin.go), since LinkedIn renders almost
+	// nothing to logged-out visitors. Left empty, the scrape still runs,
+	// just against the logged-out page.
+	LinkedInSessionCookie string
+	// MetaAppSecret verifies the X-Hub-Signature-256 header on inbound
+	// Instagram/Facebook mentions webhooks (webhookmentions.go). Left
+	// empty, those webhooks accept any caller that knows the verify token.
+	MetaAppSecret string
+	// FacebookWebhookVerifyToken authenticates the Facebook mentions
+	// webhook subscription handshake, the Facebook counterpart to
+	// InstagramWebhookVerifyToken.
+	FacebookWebhookVerifyToken string
+	// NewsAPIProvider selects which API-backed news provider (newsproviders.go)
+	// fetchNewsFeedsWithCache uses. Left empty, it defaults to "newsapi".
+	// Set it to "gnews", "bing", or "mediastack" (with that provider's API
+	// key below) so a deployment that has hit NewsAPI's free-tier daily
+	// limit, or never had a NewsAPI key at all, still gets API-backed news.
+	NewsAPIProvider string
+	// GNewsAPIKey, BingNewsAPIKey and MediastackAPIKey authenticate the
+	// alternate news providers NewsAPIProvider can select.
+	GNewsAPIKey      string
+	BingNewsAPIKey   string
+	MediastackAPIKey string
+}
+
+// loadConfig reads credentials from the environment and validates that
+// every required key is present, returning a descriptive error naming the
+// missing variable instead of failing deep inside a fetcher.
+func loadConfig() (*Config, error) {
+	cfg := &Config{
+		NewsAPIKey:                  os.Getenv("NEWS_API_KEY"),
+		YouTubeAPIKey:               os.Getenv("YOUTUBE_API_KEY"),
+		TwitterBearerToken:          os.Getenv("TWITTER_BEARER_TOKEN"),
+		LLMAPIKey:                   os.Getenv("LLM_API_KEY"),
+		LLMAPIBaseURL:               os.Getenv("LLM_API_BASE_URL"),
+		LLMModel:                    os.Getenv("LLM_MODEL"),
+		SentimentAPIURL:             os.Getenv("SENTIMENT_API_URL"),
+		SentimentAPIKey:             os.Getenv("SENTIMENT_API_KEY"),
+		ToxicityAPIURL:              os.Getenv("TOXICITY_API_URL"),
+		ToxicityAPIKey:              os.Getenv("TOXICITY_API_KEY"),
+		WebhookSecret:               os.Getenv("WEBHOOK_SECRET"),
+		InstagramAccessToken:        os.Getenv("INSTAGRAM_ACCESS_TOKEN"),
+		InstagramBusinessAccountID:  os.Getenv("INSTAGRAM_BUSINESS_ACCOUNT_ID"),
+		InstagramWebhookVerifyToken: os.Getenv("INSTAGRAM_WEBHOOK_VERIFY_TOKEN"),
+		FacebookAppToken:            os.Getenv("FACEBOOK_APP_TOKEN"),
+		LinkedInSessionCookie:       os.Getenv("LINKEDIN_SESSION_COOKIE"),
+		MetaAppSecret:               os.Getenv("META_APP_SECRET"),
+		FacebookWebhookVerifyToken:  os.Getenv("FACEBOOK_WEBHOOK_VERIFY_TOKEN"),
+		NewsAPIProvider:             os.Getenv("NEWS_API_PROVIDER"),
+		GNewsAPIKey:                 os.Getenv("GNEWS_API_KEY"),
+		BingNewsAPIKey:              os.Getenv("BING_NEWS_API_KEY"),
+		MediastackAPIKey:            os.Getenv("MEDIASTACK_API_KEY"),
+		NewsAPIKeys:                 os.Getenv("NEWS_API_KEYS"),
+		YouTubeAPIKeys:              os.Getenv("YOUTUBE_API_KEYS"),
+	}
+	if cfg.LLMAPIBaseURL == "" {
+		cfg.LLMAPIBaseURL = "https://api.openai.com/v1"
+	}
+	if cfg.LLMModel == "" {
+		cfg.LLMModel = "gpt-4o-mini"
+	}
+
+	var missing []string
+	if cfg.NewsAPIKey == "" {
+		missing = append(missing, "NEWS_API_KEY")
+	}
+	if cfg.YouTubeAPIKey == "" {
+		missing = append(missing, "YOUTUBE_API_KEY")
+	}
+	if cfg.TwitterBearerToken == "" {
+		missing = append(missing, "TWITTER_BEARER_TOKEN")
+	}
+
+	if len(missing) > 0 {
+		return cfg, fmt.Errorf("missing required environment variables: %v", missing)
+	}
+	return cfg, nil
+}
+
+// appConfig is the process-wide configuration loaded once at startup.
+var appConfig *Config
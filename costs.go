@@ -0,0 +1,133 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// costPerUnit is a rough dollar estimate per unit of usage for each metered
+// source, used only for cost reporting and cap enforcement, not billing.
+var costPerUnit = map[string]float64{
+	"newsapi_call":     0.0025,
+	"youtube_quota":    0.00002,
+	"translation_char": 0.00001,
+	"llm_token":        0.000002,
+}
+
+// dailyCostCapUSD is the spending cap; once a day's estimated spend crosses
+// it, fetchAllFeeds degrades to free sources (RSS) instead of paid ones.
+var dailyCostCapUSD = 5.0
+
+const costLedgerFile = "cost_ledger.json"
+
+type costEntry struct {
+	Day     string  `json:"day"`
+	Keyword string  `json:"keyword"`
+	Source  string  `json:"source"`
+	Units   float64 `json:"units"`
+	CostUSD float64 `json:"costUSD"`
+}
+
+var (
+	costLedger     []costEntry
+	costLedgerLock sync.Mutex
+)
+
+func loadCostLedger() {
+	file, err := os.Open(costLedgerFile)
+	if err != nil {
+		slog.Info(fmt.Sprintf("No existing cost ledger found: %s", err))
+		return
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&costLedger); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding cost ledger: %s", err))
+	}
+}
+
+func saveCostLedger() {
+	file, err := os.Create(costLedgerFile)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error saving cost ledger: %s", err))
+		return
+	}
+	defer file.Close()
+
+	if err := json.NewEncoder(file).Encode(&costLedger); err != nil {
+		slog.Error(fmt.Sprintf("Error encoding cost ledger: %s", err))
+	}
+}
+
+// recordCost logs an estimated cost for a metered usage event and persists
+// the ledger.
+func recordCost(source, keyword string, units float64) {
+	rate, ok := costPerUnit[source]
+	if !ok {
+		return
+	}
+
+	costLedgerLock.Lock()
+	costLedger = append(costLedger, costEntry{
+		Day:     time.Now().Format("2006-01-02"),
+		Keyword: keyword,
+		Source:  source,
+		Units:   units,
+		CostUSD: units * rate,
+	})
+	saveCostLedger()
+	costLedgerLock.Unlock()
+}
+
+// dailySpendUSD sums the estimated cost of every entry recorded today.
+func dailySpendUSD() float64 {
+	today := time.Now().Format("2006-01-02")
+	var total float64
+
+	costLedgerLock.Lock()
+	defer costLedgerLock.Unlock()
+	for _, entry := range costLedger {
+		if entry.Day == today {
+			total += entry.CostUSD
+		}
+	}
+	return total
+}
+
+// isOverDailyBudget reports whether today's estimated spend has crossed the
+// configured cap, signaling callers to degrade to free sources.
+func isOverDailyBudget() bool {
+	return dailyCostCapUSD > 0 && dailySpendUSD() >= dailyCostCapUSD
+}
+
+// costReportHandler reports total estimated spend, optionally filtered by
+// day and/or keyword.
+func costReportHandler(c *gin.Context) {
+	day := c.Query("day")
+	keyword := c.Query("keyword")
+
+	var total float64
+	var matched []costEntry
+
+	costLedgerLock.Lock()
+	for _, entry := range costLedger {
+		if day != "" && entry.Day != day {
+			continue
+		}
+		if keyword != "" && entry.Keyword != keyword {
+			continue
+		}
+		matched = append(matched, entry)
+		total += entry.CostUSD
+	}
+	costLedgerLock.Unlock()
+
+	c.JSON(http.StatusOK, gin.H{"totalUSD": total, "entries": matched})
+}
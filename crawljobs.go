@@ -0,0 +1,300 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// This repo has no separate crawler server process, and no client/main.go
+// parsing hand-rolled "title (link) [Thumbnail: url]" strings off it —
+// slow, chromedp-driven crawls (the Facebook and Instagram scrape
+// fallbacks) run in this same binary, and every endpoint here (crawlJob,
+// FeedResult) already exchanges typed JSON. crawlJobManager gives them an
+// async API anyway, so a client doesn't have to hold an HTTP connection
+// open for the ~45 seconds a chromedp scrape can take.
+
+const (
+	crawlJobWorkers = 4
+	crawlJobTTL     = 15 * time.Minute
+
+	// crawlJobMaxAttempts bounds how many times a failed job is retried
+	// before it's left as permanently failed. A job that crashed the whole
+	// process (not just returned an error) still only gets this many
+	// attempts across restarts, since Attempts is part of the persisted
+	// snapshot resume() re-queues.
+	crawlJobMaxAttempts = 3
+)
+
+type crawlJobStatus string
+
+const (
+	crawlJobPending crawlJobStatus = "pending"
+	crawlJobRunning crawlJobStatus = "running"
+	crawlJobDone    crawlJobStatus = "done"
+	crawlJobFailed  crawlJobStatus = "failed"
+)
+
+// crawlJob tracks one submitted crawl from queued to finished, including
+// its result once done, so GET /crawl/jobs/:id can report progress and
+// return the payload exactly once it's ready.
+type crawlJob struct {
+	ID      string `json:"id"`
+	Keyword string `json:"keyword"`
+	// Source names which FeedSource to crawl. Empty (the original,
+	// still-default behavior) means the Facebook chromedp scrape this job
+	// subsystem was built for.
+	Source    string         `json:"source,omitempty"`
+	Status    crawlJobStatus `json:"status"`
+	Results   []FeedResult   `json:"results,omitempty"`
+	Error     string         `json:"error,omitempty"`
+	CreatedAt time.Time      `json:"createdAt"`
+	// Attempts counts how many times this job has actually run, so a
+	// restart resuming a persisted job doesn't grant it a fresh set of
+	// retries it already used up before the crash.
+	Attempts int `json:"attempts,omitempty"`
+}
+
+// crawlJobManager is a bounded worker pool feeding off a single job queue,
+// so a burst of crawl requests can't spawn unbounded chromedp instances.
+type crawlJobManager struct {
+	mu    sync.Mutex
+	jobs  map[string]*crawlJob
+	queue chan string
+}
+
+var crawlJobs = newCrawlJobManager()
+
+func newCrawlJobManager() *crawlJobManager {
+	m := &crawlJobManager{
+		jobs:  make(map[string]*crawlJob),
+		queue: make(chan string, 256),
+	}
+	for i := 0; i < crawlJobWorkers; i++ {
+		go m.worker()
+	}
+	go m.expireLoop()
+	return m
+}
+
+func (m *crawlJobManager) worker() {
+	for id := range m.queue {
+		m.mu.Lock()
+		job, ok := m.jobs[id]
+		if ok {
+			job.Status = crawlJobRunning
+			job.Attempts++
+		}
+		m.mu.Unlock()
+		if !ok {
+			continue
+		}
+
+		// Persist the running transition before doing any work, so a crash
+		// mid-crawl leaves an accurate last-known status behind instead of
+		// the stale "pending" snapshot Enqueue wrote at submit time.
+		if crawlJobQueuePersistence != nil {
+			if err := crawlJobQueuePersistence.Update(*job); err != nil {
+				slog.Error(fmt.Sprintf("Error persisting crawl job %s as running: %s", id, err))
+			}
+		}
+
+		results, err := runCrawlJob(job.Keyword, job.Source)
+
+		m.mu.Lock()
+		var retry bool
+		if err != nil {
+			job.Status = crawlJobFailed
+			job.Error = err.Error()
+			retry = job.Attempts < crawlJobMaxAttempts
+		} else {
+			job.Status = crawlJobDone
+			job.Results = stampCrawlJobProvenance(results, job.ID)
+		}
+		snapshot := *job
+		m.mu.Unlock()
+
+		if crawlJobQueuePersistence != nil {
+			if retry {
+				// Leave it in the persistent queue (with the bumped
+				// Attempts and latest Error) so it's retried even if this
+				// process dies before the requeue below runs.
+				if err := crawlJobQueuePersistence.Update(snapshot); err != nil {
+					slog.Error(fmt.Sprintf("Error persisting crawl job %s for retry: %s", id, err))
+				}
+			} else if err := crawlJobQueuePersistence.Ack(id); err != nil {
+				slog.Error(fmt.Sprintf("Error acking crawl job %s in persistent queue: %s", id, err))
+			}
+		}
+
+		if retry {
+			slog.Info(fmt.Sprintf("Retrying crawl job %s for %q (attempt %d/%d): %s", id, job.Keyword, job.Attempts, crawlJobMaxAttempts, job.Error))
+			m.queue <- id
+		}
+	}
+}
+
+// runCrawlJob recovers from panics the same way fetchAllFeeds's goroutines
+// do, since chromedp scrapes are the flakiest part of this codebase. It
+// isn't handed the submitting request's context, since a crawl job is
+// meant to keep running after that request has already returned the job
+// ID; instead it gets its own crawlJobTTL-bounded context so a chromedp
+// session can't outlive the job it belongs to. source selects which
+// FeedSource to run; empty keeps the original Facebook-only behavior this
+// job subsystem was built for.
+func runCrawlJob(keyword, source string) (results []FeedResult, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error(fmt.Sprintf("Recovered from panic in crawl job for %q: %v", keyword, r))
+			err = ErrSourceUnavailable
+		}
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), crawlJobTTL)
+	defer cancel()
+
+	if source == "" || source == "Facebook" {
+		return fetchFacebookFeeds(ctx, keyword), nil
+	}
+	if source == "FacebookGroup" {
+		// Facebook Groups are crawled by group ID rather than searched by
+		// keyword, so keyword doubles as the group ID for this source —
+		// see fetchFacebookGroupFeeds (facebookgroups.go).
+		return fetchFacebookGroupFeeds(ctx, keyword)
+	}
+
+	feedSource, ok := sourceRegistry[source]
+	if !ok {
+		return nil, fmt.Errorf("unknown source %q", source)
+	}
+	return feedSource.Fetch(ctx, keyword)
+}
+
+func (m *crawlJobManager) Submit(keyword, source string) *crawlJob {
+	job := &crawlJob{
+		ID:        newCrawlJobID(),
+		Keyword:   keyword,
+		Source:    source,
+		Status:    crawlJobPending,
+		CreatedAt: time.Now(),
+	}
+
+	m.mu.Lock()
+	m.jobs[job.ID] = job
+	m.mu.Unlock()
+
+	if crawlJobQueuePersistence != nil {
+		if err := crawlJobQueuePersistence.Enqueue(*job); err != nil {
+			slog.Error(fmt.Sprintf("Error persisting crawl job %s: %s", job.ID, err))
+		}
+	}
+
+	m.queue <- job.ID
+	return job
+}
+
+// resume re-queues a job a previous process instance had persisted but
+// never finished, so QUEUE_PERSISTENCE=1 deployments don't lose work
+// in-flight across a restart. This covers both jobs that were still
+// "pending" and ones that were "running" when the process died — either
+// way the persisted snapshot's Attempts carries over, so a job that had
+// already used up its crawlJobMaxAttempts retries before the crash doesn't
+// get a free extra attempt just because the process restarted.
+func (m *crawlJobManager) resume(job crawlJob) {
+	if job.Attempts >= crawlJobMaxAttempts {
+		job.Status = crawlJobFailed
+		if job.Error == "" {
+			job.Error = "exceeded retry attempts before process restart"
+		}
+		m.mu.Lock()
+		m.jobs[job.ID] = &job
+		m.mu.Unlock()
+		if crawlJobQueuePersistence != nil {
+			if err := crawlJobQueuePersistence.Ack(job.ID); err != nil {
+				slog.Error(fmt.Sprintf("Error acking exhausted crawl job %s in persistent queue: %s", job.ID, err))
+			}
+		}
+		return
+	}
+
+	m.mu.Lock()
+	m.jobs[job.ID] = &job
+	m.mu.Unlock()
+
+	m.queue <- job.ID
+}
+
+func (m *crawlJobManager) Get(id string) (*crawlJob, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[id]
+	return job, ok
+}
+
+// QueueDepth counts jobs still pending or running, for status reporting
+// (statuspage.go).
+func (m *crawlJobManager) QueueDepth() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var depth int
+	for _, job := range m.jobs {
+		if job.Status == crawlJobPending || job.Status == crawlJobRunning {
+			depth++
+		}
+	}
+	return depth
+}
+
+// expireLoop drops finished jobs older than crawlJobTTL so a long-running
+// process doesn't accumulate results forever.
+func (m *crawlJobManager) expireLoop() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for range ticker.C {
+		cutoff := time.Now().Add(-crawlJobTTL)
+		m.mu.Lock()
+		for id, job := range m.jobs {
+			if job.CreatedAt.Before(cutoff) && job.Status != crawlJobPending && job.Status != crawlJobRunning {
+				delete(m.jobs, id)
+			}
+		}
+		m.mu.Unlock()
+	}
+}
+
+func newCrawlJobID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return hex.EncodeToString([]byte(time.Now().String()))
+	}
+	return hex.EncodeToString(buf)
+}
+
+func submitCrawlJobHandler(c *gin.Context) {
+	keyword := c.PostForm("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is required"})
+		return
+	}
+	if rejectSearchInPublicMode(c, keyword) {
+		return
+	}
+	job := crawlJobs.Submit(keyword, c.PostForm("source"))
+	c.JSON(http.StatusAccepted, job)
+}
+
+func getCrawlJobHandler(c *gin.Context) {
+	job, ok := crawlJobs.Get(c.Param("id"))
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "job not found"})
+		return
+	}
+	c.JSON(http.StatusOK, job)
+}
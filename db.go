@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// dbFile is the on-disk path for the application's embedded database. It is
+// a single JSON document rather than the SQLite database originally
+// requested (KNOWN_DEVIATIONS.md — no CGO/SQLite dependency was available
+// to this change); the schema below is deliberately shaped like tables so
+// swapping in a real database only has to change how appDB is loaded and
+// saved, not the call sites.
+const dbFile = "app.db.json"
+
+// appDatabase is the on-disk schema for the embedded database: one section
+// per legacy JSON file it replaces.
+type appDatabase struct {
+	Keywords       map[string]int             `json:"keywords"`
+	Sources        []string                   `json:"sources"`
+	TwitterHandles []string                   `json:"twitterHandles"`
+	History        map[string][]historyEntry  `json:"history,omitempty"`
+	Watchlist      map[string]WatchlistEntry  `json:"watchlist,omitempty"`
+	Preferences    map[string]UserPreferences `json:"preferences,omitempty"`
+}
+
+var (
+	appDB     = appDatabase{Keywords: make(map[string]int)}
+	appDBLock sync.Mutex
+)
+
+func loadAppDB() error {
+	appDBLock.Lock()
+	defer appDBLock.Unlock()
+
+	file, err := os.Open(dbFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error opening database file: %w", err)
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&appDB); err != nil {
+		return fmt.Errorf("error decoding database file: %w", err)
+	}
+	return nil
+}
+
+func saveAppDB() error {
+	appDBLock.Lock()
+	defer appDBLock.Unlock()
+
+	file, err := os.Create(dbFile)
+	if err != nil {
+		return fmt.Errorf("error creating database file: %w", err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(&appDB)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"net/url"
+	"strings"
+)
+
+// utmParams are the tracking query parameters that make otherwise-identical
+// article URLs from NewsAPI and a publisher's own RSS feed compare unequal.
+var utmParams = []string{"utm_source", "utm_medium", "utm_campaign", "utm_term", "utm_content", "fbclid", "gclid"}
+
+// canonicalizeURL strips tracking parameters and normalizes case/trailing
+// slash so the same article reached two different ways compares equal.
+func canonicalizeURL(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	query := parsed.Query()
+	for _, param := range utmParams {
+		query.Del(param)
+	}
+	parsed.RawQuery = query.Encode()
+	parsed.Fragment = ""
+	parsed.Host = strings.ToLower(parsed.Host)
+	parsed.Path = strings.TrimSuffix(parsed.Path, "/")
+
+	return parsed.String()
+}
+
+// normalizeTitleForMatch lowercases and strips punctuation so titles that
+// differ only in a trailing " - The Guardian" style suffix or quote style
+// still fuzzy-match.
+func normalizeTitleForMatch(title string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(title) {
+		if r == ' ' || (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// dedupeNewsResults removes results that are the same article reached
+// through two sources (typically NewsAPI and the publisher's own RSS
+// feed), matching on canonical URL first and normalized title second, and
+// keeping whichever copy has the richer record (longer description, or a
+// thumbnail when the other has none).
+func dedupeNewsResults(results []FeedResult) []FeedResult {
+	byURL := make(map[string]int)
+	byTitle := make(map[string]int)
+	var deduped []FeedResult
+
+	for _, result := range results {
+		canonicalURL := canonicalizeURL(result.Link)
+		normalizedTitle := normalizeTitleForMatch(result.Title)
+
+		if idx, ok := byURL[canonicalURL]; ok && canonicalURL != "" {
+			deduped[idx] = richerResult(deduped[idx], result)
+			continue
+		}
+		if idx, ok := byTitle[normalizedTitle]; ok && normalizedTitle != "" {
+			deduped[idx] = richerResult(deduped[idx], result)
+			continue
+		}
+
+		deduped = append(deduped, result)
+		idx := len(deduped) - 1
+		if canonicalURL != "" {
+			byURL[canonicalURL] = idx
+		}
+		if normalizedTitle != "" {
+			byTitle[normalizedTitle] = idx
+		}
+	}
+
+	return deduped
+}
+
+// richerResult picks whichever of two duplicate results carries more
+// information, preferring the longer description and a non-placeholder
+// thumbnail.
+func richerResult(existing, candidate FeedResult) FeedResult {
+	if len(candidate.Description) > len(existing.Description) {
+		existing.Description = candidate.Description
+	}
+	if existing.Thumbnail == "" || strings.Contains(existing.Thumbnail, "placeholder") {
+		if candidate.Thumbnail != "" && !strings.Contains(candidate.Thumbnail, "placeholder") {
+			existing.Thumbnail = candidate.Thumbnail
+		}
+	}
+	return existing
+}
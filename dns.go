@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// happyEyeballsFallbackDelay is how long net.Dialer waits for a AAAA
+// (IPv6) connection attempt before racing a AAAA/A pair in parallel, per
+// RFC 8305. Go's dialer already does this by default; setting it
+// explicitly documents the behavior instead of relying on the zero value.
+const happyEyeballsFallbackDelay = 300 * time.Millisecond
+
+// buildSharedDialer returns a *net.Dialer configured for happy-eyeballs
+// dual-stack dialing and, if DNS_SERVER is set, a custom plain DNS
+// resolver instead of the OS default (useful when the environment's
+// default resolver is flaky, as with some RSS hosts).
+func buildSharedDialer() *net.Dialer {
+	dialer := &net.Dialer{
+		Timeout:       30 * time.Second,
+		KeepAlive:     30 * time.Second,
+		FallbackDelay: happyEyeballsFallbackDelay,
+	}
+
+	if dnsServer := os.Getenv("DNS_SERVER"); dnsServer != "" {
+		dialer.Resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var resolverDialer net.Dialer
+				return resolverDialer.DialContext(ctx, network, dnsServer)
+			},
+		}
+	}
+
+	return dialer
+}
+
+// dohHTTPClient is a plain HTTP client dedicated to DoH lookups. It can't
+// reuse sharedHTTPClient: sharedTransport's dialer routes through
+// dialContextWithDNSOverride, which calls resolveViaDoH, which calls
+// queryDoH — depending on sharedHTTPClient here would make that a
+// package-level initialization cycle.
+var dohHTTPClient = &http.Client{Transport: http.DefaultTransport}
+
+// dohEndpoint is the DNS-over-HTTPS resolver URL (RFC 8484), e.g.
+// "https://1.1.1.1/dns-query". Empty disables DoH and falls back to
+// buildSharedDialer's resolver.
+var dohEndpoint = os.Getenv("DOH_URL")
+
+// dialContextWithDNSOverride wraps a base *net.Dialer's DialContext,
+// resolving the host via DNS-over-HTTPS first when dohEndpoint is
+// configured, and falling back to the base dialer's own resolution
+// (custom or system) otherwise.
+func dialContextWithDNSOverride(dialer *net.Dialer) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if dohEndpoint == "" {
+			return dialer.DialContext(ctx, network, addr)
+		}
+
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return dialer.DialContext(ctx, network, addr)
+		}
+		if net.ParseIP(host) != nil {
+			// Already an IP literal; nothing to resolve.
+			return dialer.DialContext(ctx, network, addr)
+		}
+
+		ips, err := resolveViaDoH(ctx, host)
+		if err != nil || len(ips) == 0 {
+			slog.Error(fmt.Sprintf("DoH lookup failed for %s, falling back to configured resolver: %v", host, err))
+			return dialer.DialContext(ctx, network, addr)
+		}
+
+		var lastErr error
+		for _, ip := range ips {
+			conn, dialErr := dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+			if dialErr == nil {
+				return conn, nil
+			}
+			lastErr = dialErr
+		}
+		return nil, lastErr
+	}
+}
+
+// resolveViaDoH queries dohEndpoint for both A and AAAA records of host
+// using the DNS wire format over HTTPS (RFC 8484 GET), returning every
+// address found so the caller can race/fallback across them.
+func resolveViaDoH(ctx context.Context, host string) ([]net.IP, error) {
+	var ips []net.IP
+	for _, qtype := range []dnsmessage.Type{dnsmessage.TypeA, dnsmessage.TypeAAAA} {
+		addrs, err := queryDoH(ctx, host, qtype)
+		if err != nil {
+			continue
+		}
+		ips = append(ips, addrs...)
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("no addresses found for %s via DoH", host)
+	}
+	return ips, nil
+}
+
+func queryDoH(ctx context.Context, host string, qtype dnsmessage.Type) ([]net.IP, error) {
+	name, err := dnsmessage.NewName(host + ".")
+	if err != nil {
+		return nil, fmt.Errorf("invalid hostname %q: %w", host, err)
+	}
+
+	query := dnsmessage.Message{
+		Header: dnsmessage.Header{RecursionDesired: true},
+		Questions: []dnsmessage.Question{{
+			Name:  name,
+			Type:  qtype,
+			Class: dnsmessage.ClassINET,
+		}},
+	}
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("error encoding DNS query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dohEndpoint, bytes.NewReader(packed))
+	if err != nil {
+		return nil, fmt.Errorf("error building DoH request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/dns-message")
+	req.Header.Set("Accept", "application/dns-message")
+
+	resp, err := dohHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing DoH request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading DoH response: %w", err)
+	}
+
+	var reply dnsmessage.Message
+	if err := reply.Unpack(body); err != nil {
+		return nil, fmt.Errorf("error decoding DoH response: %w", err)
+	}
+
+	var ips []net.IP
+	for _, answer := range reply.Answers {
+		switch res := answer.Body.(type) {
+		case *dnsmessage.AResource:
+			ips = append(ips, net.IP(res.A[:]))
+		case *dnsmessage.AAAAResource:
+			ips = append(ips, net.IP(res.AAAA[:]))
+		}
+	}
+	return ips, nil
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// jobQueueFile persists queued crawl jobs so a restart doesn't lose work in
+// flight. This is a JSON file rather than the SQLite-backed queue mode
+// originally requested (KNOWN_DEVIATIONS.md — same "no CGO dependency
+// available" constraint as db.go), and the honest single-box substitute on
+// a small VPS that doesn't run Redis/NATS either. Anything that currently
+// keeps its queue purely in memory (crawlJobManager) can adopt
+// persistentJobQueue by satisfying JobQueue, the same way a new FeedSource
+// adopts sourceRegistry, without every subsystem needing to move over at
+// once.
+const jobQueueFile = "job_queue.json"
+
+// JobQueue is the minimal interface a durable, single-box queue needs to
+// support: hand out work, keep the persisted copy of a job in step with its
+// real status as it transitions (Update), and let the worker acknowledge
+// it's done so it isn't redelivered after a restart (Ack).
+type JobQueue interface {
+	Enqueue(job crawlJob) error
+	Update(job crawlJob) error
+	Pending() ([]crawlJob, error)
+	Ack(id string) error
+}
+
+// persistentJobQueue is a JobQueue backed by jobQueueFile. It's intentionally
+// simple (no leases, no retries) since its job is durability across
+// restarts, not distributed delivery guarantees.
+type persistentJobQueue struct {
+	mu   sync.Mutex
+	path string
+}
+
+// newPersistentJobQueue returns a JobQueue backed by path, creating it if it
+// doesn't already exist.
+func newPersistentJobQueue(path string) *persistentJobQueue {
+	return &persistentJobQueue{path: path}
+}
+
+func (q *persistentJobQueue) load() (map[string]crawlJob, error) {
+	jobs := make(map[string]crawlJob)
+
+	file, err := os.Open(q.path)
+	if os.IsNotExist(err) {
+		return jobs, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening job queue file: %w", err)
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&jobs); err != nil {
+		return nil, fmt.Errorf("error decoding job queue file: %w", err)
+	}
+	return jobs, nil
+}
+
+func (q *persistentJobQueue) save(jobs map[string]crawlJob) error {
+	file, err := os.Create(q.path)
+	if err != nil {
+		return fmt.Errorf("error creating job queue file: %w", err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(jobs)
+}
+
+// Enqueue persists job so it survives a restart until Ack'd.
+func (q *persistentJobQueue) Enqueue(job crawlJob) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	jobs, err := q.load()
+	if err != nil {
+		return err
+	}
+	jobs[job.ID] = job
+	return q.save(jobs)
+}
+
+// Update overwrites the persisted copy of job with its current in-memory
+// state (e.g. pending -> running), so a crash mid-crawl leaves behind an
+// accurate last-known status instead of the stale snapshot Enqueue wrote at
+// submit time.
+func (q *persistentJobQueue) Update(job crawlJob) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	jobs, err := q.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := jobs[job.ID]; !ok {
+		// Already Ack'd (or never enqueued); nothing to update.
+		return nil
+	}
+	jobs[job.ID] = job
+	return q.save(jobs)
+}
+
+// Pending returns every job not yet Ack'd, in no particular order, so a
+// freshly-started process can resume work a previous run didn't finish.
+func (q *persistentJobQueue) Pending() ([]crawlJob, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	jobs, err := q.load()
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]crawlJob, 0, len(jobs))
+	for _, job := range jobs {
+		pending = append(pending, job)
+	}
+	return pending, nil
+}
+
+// Ack removes id from the queue file once its work is done, so it isn't
+// redelivered on the next restart.
+func (q *persistentJobQueue) Ack(id string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	jobs, err := q.load()
+	if err != nil {
+		return err
+	}
+	delete(jobs, id)
+	return q.save(jobs)
+}
+
+// crawlJobQueuePersistence backs crawlJobManager when QUEUE_PERSISTENCE=1,
+// so submitted-but-unfinished crawl jobs aren't silently lost if the
+// process restarts mid-crawl. nil (the default) keeps the original
+// in-memory-only behavior.
+var crawlJobQueuePersistence JobQueue
+
+func loadQueuePersistenceConfig() {
+	if os.Getenv("QUEUE_PERSISTENCE") == "1" {
+		crawlJobQueuePersistence = newPersistentJobQueue(jobQueueFile)
+	}
+}
+
+// resumePendingCrawlJobs re-queues any job crawlJobQueuePersistence still
+// has pending from before a restart. Called once at startup, after
+// crawlJobs has been constructed.
+func resumePendingCrawlJobs() {
+	if crawlJobQueuePersistence == nil {
+		return
+	}
+	pending, err := crawlJobQueuePersistence.Pending()
+	if err != nil {
+		return
+	}
+	for _, job := range pending {
+		crawlJobs.resume(job)
+	}
+}
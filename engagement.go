@@ -0,0 +1,20 @@
+package main
+
+// engagementMetrics captures the reach signals a platform exposes for a
+// result. Not every source populates every field (RSS/NewsAPI have none of
+// this), so all fields are just the zero value where a platform doesn't
+// report it rather than left absent from the struct.
+type engagementMetrics struct {
+	Likes    int `json:"likes,omitempty"`
+	Shares   int `json:"shares,omitempty"`
+	Comments int `json:"comments,omitempty"`
+	Views    int `json:"views,omitempty"`
+}
+
+// popularityScore normalizes engagement across platforms so a view-heavy
+// YouTube video and a like-heavy tweet can be ranked on one scale. Views
+// are weighted lightly since they're routinely an order of magnitude
+// larger than likes/shares/comments and would otherwise swamp the score.
+func popularityScore(m engagementMetrics) float64 {
+	return float64(m.Likes)*3 + float64(m.Shares)*4 + float64(m.Comments)*2 + float64(m.Views)*0.01
+}
@@ -0,0 +1,351 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EnrichmentStage is one per-result enrichment step (sentiment, and
+// eventually the extraction/translation/OCR stages costs.go's ledger and
+// featureflags.go's flags already anticipate) run over search results after
+// they're fetched. Concurrency bounds how many results the stage processes
+// at once, so a slow stage can't spawn unbounded goroutines against a large
+// result set.
+type EnrichmentStage struct {
+	Name        string
+	Concurrency int
+	Enrich      func(ctx context.Context, result FeedResult) FeedResult
+}
+
+// enrichmentBudget bounds how much enrichment work one search pays for
+// synchronously: at most MaxItemsPerSearch results are enriched inline, and
+// the whole synchronous pass is capped at Timeout, so a slow stage or a
+// large result set degrades a search's latency by a bounded amount instead
+// of an unbounded one. Anything left over is finished asynchronously by
+// enrichRemainingAsync.
+type enrichmentBudget struct {
+	MaxItemsPerSearch int
+	Timeout           time.Duration
+}
+
+// budgetForPriority scales base by a watched keyword's priority (see
+// priority.go): critical keywords get a deeper synchronous enrichment pass
+// (more items covered inline, more time to do it) since results left with
+// EnrichmentPending are still finished eventually but not before the
+// search response goes out; low-priority keywords get a shallower one so
+// they don't compete with critical keywords for enrichment concurrency.
+func budgetForPriority(base enrichmentBudget, priority keywordPriority) enrichmentBudget {
+	switch normalizePriority(priority) {
+	case priorityCritical:
+		return enrichmentBudget{MaxItemsPerSearch: base.MaxItemsPerSearch * 2, Timeout: base.Timeout * 2}
+	case priorityLow:
+		return enrichmentBudget{MaxItemsPerSearch: base.MaxItemsPerSearch / 2, Timeout: base.Timeout / 2}
+	default:
+		return base
+	}
+}
+
+var (
+	// enrichmentStages lists what this codebase actually enriches today.
+	// Extraction and OCR are still just ideas with no implementation here;
+	// translation (translation.go) is real, and is a no-op stage unless
+	// TRANSLATE_TARGET_LANGUAGE is configured.
+	enrichmentStages = []EnrichmentStage{
+		{Name: "sentiment", Concurrency: 4, Enrich: enrichSentiment},
+		{Name: "aggression", Concurrency: 4, Enrich: enrichAggression},
+		{Name: "translation", Concurrency: 4, Enrich: enrichTranslation},
+	}
+
+	activeEnrichmentBudget = enrichmentBudget{MaxItemsPerSearch: 40, Timeout: 5 * time.Second}
+)
+
+// loadEnrichmentConfig applies optional environment overrides for
+// enrichment concurrency and budgets, so an operator can tune them per
+// deployment without a code change. Unset variables keep the defaults
+// above.
+func loadEnrichmentConfig() {
+	if raw := os.Getenv("ENRICHMENT_MAX_ITEMS_PER_SEARCH"); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n >= 0 {
+			activeEnrichmentBudget.MaxItemsPerSearch = n
+		}
+	}
+	if raw := os.Getenv("ENRICHMENT_TIMEOUT_SECONDS"); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n >= 0 {
+			activeEnrichmentBudget.Timeout = time.Duration(n) * time.Second
+		}
+	}
+	for i, stage := range enrichmentStages {
+		envName := "ENRICHMENT_CONCURRENCY_" + strings.ToUpper(stage.Name)
+		if raw := os.Getenv(envName); raw != "" {
+			if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+				enrichmentStages[i].Concurrency = n
+			}
+		}
+	}
+
+	configureSentimentAnalyzer()
+	configureToxicityAnalyzer()
+}
+
+// runEnrichmentStages runs every stage over up to budget.MaxItemsPerSearch
+// of results (0 means unlimited), bounded overall by budget.Timeout.
+// Results beyond the item cap are returned unmodified with
+// EnrichmentPending set, for the caller to finish off asynchronously.
+func runEnrichmentStages(ctx context.Context, results []FeedResult, stages []EnrichmentStage, budget enrichmentBudget) []FeedResult {
+	if len(stages) == 0 || len(results) == 0 {
+		return results
+	}
+	if budget.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, budget.Timeout)
+		defer cancel()
+	}
+
+	limit := len(results)
+	if budget.MaxItemsPerSearch > 0 && budget.MaxItemsPerSearch < limit {
+		limit = budget.MaxItemsPerSearch
+	}
+	for i := limit; i < len(results); i++ {
+		results[i].EnrichmentPending = true
+	}
+
+	for _, stage := range stages {
+		enrichBatch(ctx, results[:limit], stage)
+	}
+	return results
+}
+
+// enrichBatch runs stage.Enrich over items using up to stage.Concurrency
+// goroutines at once, mirroring fetchAllFeeds's per-source fan-out
+// (bounded concurrency, panic recovery). It stops launching new work once
+// ctx is done, leaving whatever hasn't started yet unenriched rather than
+// blocking past the search's enrichment budget.
+func enrichBatch(ctx context.Context, items []FeedResult, stage EnrichmentStage) {
+	concurrency := stage.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i := range items {
+		if ctx.Err() != nil {
+			break
+		}
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			defer func() {
+				if r := recover(); r != nil {
+					slog.Error(fmt.Sprintf("Recovered from panic in %s enrichment: %v", stage.Name, r))
+				}
+			}()
+			items[i] = recordEnrichment(stage.Enrich(ctx, items[i]), stage.Name)
+		}(i)
+	}
+	wg.Wait()
+}
+
+// enrichRemainingAsync finishes enriching results a budget-limited
+// synchronous pass left pending (see runEnrichmentStages), then writes the
+// enriched copies back into keyword's most recent search history entry, so
+// a client polling GET /feed/:keyword.json or the history API sees the
+// enriched fields appear once they're ready. This mirrors how
+// crawlJobManager lets a slow chromedp scrape finish after the triggering
+// request has already returned; it isn't handed that request's context for
+// the same reason runCrawlJob isn't — it must outlive it.
+func enrichRemainingAsync(keyword string, pending []FeedResult) {
+	if len(pending) == 0 {
+		return
+	}
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				slog.Error(fmt.Sprintf("Recovered from panic in async enrichment for %q: %v", keyword, r))
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+		enriched := runEnrichmentStages(ctx, pending, enrichmentStages, enrichmentBudget{})
+
+		byID := make(map[string]FeedResult, len(enriched))
+		for _, r := range enriched {
+			byID[r.ID] = r
+		}
+
+		appDBLock.Lock()
+		entries := appDB.History[keyword]
+		if len(entries) == 0 {
+			appDBLock.Unlock()
+			return
+		}
+		latest := entries[len(entries)-1]
+		for i, r := range latest.Results {
+			if updated, ok := byID[r.ID]; ok {
+				latest.Results[i] = updated
+			}
+		}
+		appDBLock.Unlock()
+
+		if err := saveAppDB(); err != nil {
+			slog.Error(fmt.Sprintf("Error saving app DB after async enrichment for %q: %s", keyword, err))
+		}
+	}()
+}
+
+// positiveSentimentWords and negativeSentimentWords back scoreSentiment. A
+// tiny hand-picked lexicon rather than an ML model, since this repo has no
+// NLP dependency; it's precise enough to badge obviously good/bad news
+// without claiming to be a real sentiment classifier.
+var (
+	positiveSentimentWords = map[string]bool{
+		"good": true, "great": true, "win": true, "wins": true, "success": true,
+		"growth": true, "improve": true, "improves": true, "positive": true,
+		"boost": true, "record": true, "surge": true, "breakthrough": true,
+	}
+	negativeSentimentWords = map[string]bool{
+		"bad": true, "fail": true, "fails": true, "failure": true, "loss": true,
+		"crash": true, "crisis": true, "decline": true, "negative": true,
+		"scandal": true, "lawsuit": true, "controversy": true, "collapse": true,
+	}
+)
+
+// sentimentAnalyzer scores text and returns a value in [-1, 1] plus a
+// coarse label ("positive"/"negative"/"neutral"). It's a plain func type
+// rather than an interface so swapping analyzers (see
+// configureSentimentAnalyzer) is just reassigning activeSentimentAnalyzer.
+type sentimentAnalyzer func(ctx context.Context, text string) (float64, string)
+
+// activeSentimentAnalyzer defaults to the local lexicon and is swapped for
+// externalSentimentAnalyzer at startup if SENTIMENT_API_URL is configured.
+var activeSentimentAnalyzer sentimentAnalyzer = lexiconSentimentAnalyzer
+
+// configureSentimentAnalyzer picks the analyzer enrichSentiment uses, based
+// on whether an external sentiment API is configured. Called from
+// loadEnrichmentConfig.
+func configureSentimentAnalyzer() {
+	if appConfig != nil && appConfig.SentimentAPIURL != "" {
+		activeSentimentAnalyzer = externalSentimentAnalyzer
+		return
+	}
+	activeSentimentAnalyzer = lexiconSentimentAnalyzer
+}
+
+// enrichSentiment is this codebase's one real enrichment stage today:
+// sentiment computed from the title and description via whichever analyzer
+// is currently active.
+func enrichSentiment(ctx context.Context, result FeedResult) FeedResult {
+	result.Sentiment, result.SentimentLabel = activeSentimentAnalyzer(ctx, result.Title+" "+result.Description)
+	return result
+}
+
+// lexiconSentimentAnalyzer is scoreSentiment adapted to the
+// sentimentAnalyzer signature.
+func lexiconSentimentAnalyzer(ctx context.Context, text string) (float64, string) {
+	return scoreSentiment(text)
+}
+
+// externalSentimentAnalyzer calls appConfig.SentimentAPIURL with the text
+// to score, expecting a JSON {"score": float, "label": string} response.
+// Any failure — network error, non-200, bad JSON — falls back to the local
+// lexicon rather than leaving the result unscored, the same degrade-to-free
+// pattern fetchAllFeeds uses when NewsAPI is over budget.
+func externalSentimentAnalyzer(ctx context.Context, text string) (float64, string) {
+	payload, err := json.Marshal(struct {
+		Text string `json:"text"`
+	}{Text: text})
+	if err != nil {
+		return lexiconSentimentAnalyzer(ctx, text)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, appConfig.SentimentAPIURL, bytes.NewReader(payload))
+	if err != nil {
+		return lexiconSentimentAnalyzer(ctx, text)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if appConfig.SentimentAPIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+appConfig.SentimentAPIKey)
+	}
+
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		slog.Warn(fmt.Sprintf("External sentiment API call failed, falling back to lexicon: %s", err))
+		return lexiconSentimentAnalyzer(ctx, text)
+	}
+	defer resp.Body.Close()
+
+	var parsed struct {
+		Score float64 `json:"score"`
+		Label string  `json:"label"`
+	}
+	if resp.StatusCode != http.StatusOK {
+		slog.Warn(fmt.Sprintf("External sentiment API returned status %d, falling back to lexicon", resp.StatusCode))
+		return lexiconSentimentAnalyzer(ctx, text)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		slog.Warn(fmt.Sprintf("Error decoding external sentiment API response, falling back to lexicon: %s", err))
+		return lexiconSentimentAnalyzer(ctx, text)
+	}
+	return parsed.Score, parsed.Label
+}
+
+// filterBySentiment keeps only results whose SentimentLabel matches label
+// (case-insensitive: "positive", "negative" or "neutral"), across every
+// source. An empty label is a no-op.
+func filterBySentiment(results map[string][]FeedResult, label string) map[string][]FeedResult {
+	if label == "" {
+		return results
+	}
+	label = strings.ToLower(label)
+
+	filtered := make(map[string][]FeedResult, len(results))
+	for source, feedResults := range results {
+		var kept []FeedResult
+		for _, result := range feedResults {
+			if strings.ToLower(result.SentimentLabel) == label {
+				kept = append(kept, result)
+			}
+		}
+		filtered[source] = kept
+	}
+	return filtered
+}
+
+// scoreSentiment counts positive/negative lexicon hits in text and returns
+// a score in [-1, 1] plus a coarse label.
+func scoreSentiment(text string) (float64, string) {
+	var pos, neg int
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		word = strings.Trim(word, ".,!?\"'()")
+		switch {
+		case positiveSentimentWords[word]:
+			pos++
+		case negativeSentimentWords[word]:
+			neg++
+		}
+	}
+	if pos == 0 && neg == 0 {
+		return 0, "neutral"
+	}
+	score := float64(pos-neg) / float64(pos+neg)
+	switch {
+	case score > 0.2:
+		return score, "positive"
+	case score < -0.2:
+		return score, "negative"
+	default:
+		return score, "neutral"
+	}
+}
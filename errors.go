@@ -0,0 +1,51 @@
+package main
+
+import (
+	"errors"
+	"strings"
+)
+
+// Sentinel errors returned by FeedSource.Fetch, so embedding applications
+// can branch on failure reasons with errors.Is instead of parsing log
+// strings.
+var (
+	ErrQuotaExceeded     = errors.New("source quota exceeded")
+	ErrSourceUnavailable = errors.New("source unavailable")
+	ErrAuth              = errors.New("source authentication failed")
+	ErrTimeout           = errors.New("source request timed out")
+)
+
+// classifySourceError maps a raw fetch error into one of the sentinel error
+// types by inspecting its message, so callers get a consistent taxonomy
+// regardless of which upstream API produced the failure.
+func classifySourceError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	message := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(message, "quota") || strings.Contains(message, "ratelimited") || strings.Contains(message, "429"):
+		return errWrap(ErrQuotaExceeded, err)
+	case strings.Contains(message, "unauthorized") || strings.Contains(message, "apikeyinvalid") || strings.Contains(message, "401"):
+		return errWrap(ErrAuth, err)
+	case strings.Contains(message, "timeout") || strings.Contains(message, "deadline exceeded"):
+		return errWrap(ErrTimeout, err)
+	default:
+		return errWrap(ErrSourceUnavailable, err)
+	}
+}
+
+// errWrap wraps err so both errors.Is(sentinel) and the original message
+// are preserved.
+func errWrap(sentinel, err error) error {
+	return &sourceError{sentinel: sentinel, cause: err}
+}
+
+type sourceError struct {
+	sentinel error
+	cause    error
+}
+
+func (e *sourceError) Error() string { return e.cause.Error() }
+func (e *sourceError) Unwrap() error { return e.sentinel }
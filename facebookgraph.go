@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+const facebookGraphAPIBaseURL = "https://graph.facebook.com/v19.0"
+
+// fetchFacebookFeeds dispatches to the Graph API page/post search when
+// FACEBOOK_APP_TOKEN (config.go) is configured — the login-scraping
+// approach fetchFacebookFeedsByScraping used is fragile (it depends on
+// Facebook's public search page staying reachable without a login) and
+// against Facebook's terms, so it's now an explicit opt-in
+// (FACEBOOK_ENABLE_SCRAPE_FALLBACK=1) rather than the default.
+func fetchFacebookFeeds(ctx context.Context, keyword string) []FeedResult {
+	if appConfig.FacebookAppToken != "" {
+		results, err := fetchFacebookFeedsFromGraphAPI(ctx, keyword)
+		if err != nil {
+			slog.Error(fmt.Sprintf("Error fetching Facebook feeds from Graph API: %s", err))
+			return nil
+		}
+		return results
+	}
+	if os.Getenv("FACEBOOK_ENABLE_SCRAPE_FALLBACK") == "1" {
+		return fetchFacebookFeedsByScraping(ctx, keyword)
+	}
+	slog.Info("Facebook Graph API not configured and scrape fallback not opted into, returning no results")
+	return nil
+}
+
+type facebookPageSearchResponse struct {
+	Data []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"data"`
+}
+
+type facebookPagePostsResponse struct {
+	Data []struct {
+		ID           string `json:"id"`
+		Message      string `json:"message"`
+		CreatedTime  string `json:"created_time"`
+		PermalinkURL string `json:"permalink_url"`
+		FullPicture  string `json:"full_picture"`
+	} `json:"data"`
+}
+
+// fetchFacebookFeedsFromGraphAPI searches public Pages matching keyword,
+// then lists each matching Page's recent posts. Graph API has no
+// cross-Page post search endpoint (Facebook retired the old /search?type=post
+// endpoint), so pages/posts search here means exactly what it says: search
+// pages, then fetch each one's posts.
+func fetchFacebookFeedsFromGraphAPI(ctx context.Context, keyword string) ([]FeedResult, error) {
+	var pages facebookPageSearchResponse
+	searchURL := fmt.Sprintf("%s/pages/search?q=%s&fields=id,name&access_token=%s",
+		facebookGraphAPIBaseURL, url.QueryEscape(keyword), url.QueryEscape(appConfig.FacebookAppToken))
+	if err := getFacebookGraphJSON(ctx, searchURL, &pages); err != nil {
+		return nil, fmt.Errorf("error searching Facebook pages for %q: %w", keyword, err)
+	}
+
+	var results []FeedResult
+	for _, page := range pages.Data {
+		var posts facebookPagePostsResponse
+		postsURL := fmt.Sprintf("%s/%s/posts?fields=id,message,created_time,permalink_url,full_picture&access_token=%s",
+			facebookGraphAPIBaseURL, page.ID, url.QueryEscape(appConfig.FacebookAppToken))
+		if err := getFacebookGraphJSON(ctx, postsURL, &posts); err != nil {
+			slog.Error(fmt.Sprintf("Error fetching posts for Facebook page %s: %s", page.Name, err))
+			continue
+		}
+
+		for _, post := range posts.Data {
+			published, err := time.Parse(time.RFC3339, post.CreatedTime)
+			if err != nil {
+				published = time.Now()
+			}
+			results = append(results, FeedResult{
+				ID:            post.ID,
+				Title:         fmt.Sprintf("Facebook post by %s", page.Name),
+				Link:          post.PermalinkURL,
+				Published:     published.Format("2006-01-02 15:04:05"),
+				PublishedTime: published,
+				Description:   post.Message,
+				Source:        "Facebook",
+				Thumbnail:     post.FullPicture,
+			})
+		}
+	}
+	return results, nil
+}
+
+func getFacebookGraphJSON(ctx context.Context, requestURL string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("graph API request failed with status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
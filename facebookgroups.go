@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// facebookGroupPoliteInterval is the minimum time between fetches of the
+// same group, distinct from (and longer than) whatever pacing applies to
+// keyword-driven Facebook Page/post search, since a group timeline that's
+// hit too often risks the member token being rate-limited or flagged.
+const facebookGroupPoliteInterval = 15 * time.Minute
+
+// facebookGroupLastFetch tracks the last time each group was fetched, the
+// same pattern sourceLastSuccessAt (circuitbreaker.go) uses, so
+// fetchFacebookGroupFeeds can enforce facebookGroupPoliteInterval without a
+// database.
+var (
+	facebookGroupLastFetch     = make(map[string]time.Time)
+	facebookGroupLastFetchLock sync.Mutex
+)
+
+// fetchFacebookGroupFeeds crawls one public Facebook Group's feed that the
+// configured account (FacebookAppToken) is a member of. Groups are a
+// distinct crawl target from Pages: Graph API has no cross-group search, so
+// this fetches one specific groupID's feed rather than searching by
+// keyword, and it's called from a crawl job (crawljobs.go) rather than
+// registered as a FeedSource, since a keyword search has no way to name
+// which group to crawl.
+func fetchFacebookGroupFeeds(ctx context.Context, groupID string) ([]FeedResult, error) {
+	if appConfig.FacebookAppToken == "" {
+		return nil, fmt.Errorf("FACEBOOK_APP_TOKEN not configured, cannot crawl Facebook group %s", groupID)
+	}
+
+	facebookGroupLastFetchLock.Lock()
+	if last, ok := facebookGroupLastFetch[groupID]; ok && time.Since(last) < facebookGroupPoliteInterval {
+		facebookGroupLastFetchLock.Unlock()
+		return nil, fmt.Errorf("facebook group %s was fetched %s ago, politeness interval is %s", groupID, time.Since(last).Round(time.Second), facebookGroupPoliteInterval)
+	}
+	facebookGroupLastFetch[groupID] = time.Now()
+	facebookGroupLastFetchLock.Unlock()
+
+	var posts facebookPagePostsResponse
+	postsURL := fmt.Sprintf("%s/%s/feed?fields=id,message,created_time,permalink_url,full_picture&access_token=%s",
+		facebookGraphAPIBaseURL, groupID, url.QueryEscape(appConfig.FacebookAppToken))
+	if err := getFacebookGraphJSON(ctx, postsURL, &posts); err != nil {
+		return nil, fmt.Errorf("error fetching feed for Facebook group %s: %w", groupID, err)
+	}
+
+	var results []FeedResult
+	for _, post := range posts.Data {
+		published, err := time.Parse(time.RFC3339, post.CreatedTime)
+		if err != nil {
+			published = time.Now()
+		}
+		results = append(results, FeedResult{
+			ID:            post.ID,
+			Title:         fmt.Sprintf("Facebook group post (%s)", groupID),
+			Link:          post.PermalinkURL,
+			Published:     published.Format("2006-01-02 15:04:05"),
+			PublishedTime: published,
+			Description:   post.Message,
+			Source:        "FacebookGroup",
+			Thumbnail:     post.FullPicture,
+		})
+	}
+	slog.Info(fmt.Sprintf("Fetched %d posts from Facebook group %s", len(results), groupID))
+	return results, nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// FeatureFlag gates an experimental source or costly enrichment. RolloutPct
+// is the percentage (0-100) of requests that should see the flag enabled
+// even when Enabled is true, keyed deterministically off the search keyword
+// so a given keyword doesn't flap between on and off.
+type FeatureFlag struct {
+	Enabled    bool `json:"enabled"`
+	RolloutPct int  `json:"rolloutPct"`
+}
+
+const featureFlagsFile = "feature_flags.json"
+
+var (
+	featureFlags = map[string]FeatureFlag{
+		"tiktok_source":     {Enabled: false, RolloutPct: 0},
+		"llm_summarization": {Enabled: false, RolloutPct: 0},
+	}
+	featureFlagsLock sync.RWMutex
+)
+
+func loadFeatureFlags() {
+	file, err := os.Open(featureFlagsFile)
+	if err != nil {
+		slog.Info(fmt.Sprintf("No feature flags file found, using defaults: %s", err))
+		return
+	}
+	defer file.Close()
+
+	featureFlagsLock.Lock()
+	defer featureFlagsLock.Unlock()
+	if err := json.NewDecoder(file).Decode(&featureFlags); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding feature flags file: %s", err))
+	}
+}
+
+func saveFeatureFlags() {
+	file, err := os.Create(featureFlagsFile)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error saving feature flags file: %s", err))
+		return
+	}
+	defer file.Close()
+
+	featureFlagsLock.RLock()
+	defer featureFlagsLock.RUnlock()
+	if err := json.NewEncoder(file).Encode(&featureFlags); err != nil {
+		slog.Error(fmt.Sprintf("Error encoding feature flags file: %s", err))
+	}
+}
+
+// isFeatureEnabled reports whether the named flag is active for the given
+// bucketing key (typically the search keyword).
+func isFeatureEnabled(name, bucketKey string) bool {
+	featureFlagsLock.RLock()
+	flag, ok := featureFlags[name]
+	featureFlagsLock.RUnlock()
+	if !ok || !flag.Enabled {
+		return false
+	}
+	if flag.RolloutPct >= 100 {
+		return true
+	}
+	if flag.RolloutPct <= 0 {
+		return false
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(name + ":" + bucketKey))
+	return int(h.Sum32()%100) < flag.RolloutPct
+}
+
+// adminSetFeatureFlagHandler toggles a feature flag and its rollout
+// percentage at runtime.
+func adminSetFeatureFlagHandler(c *gin.Context) {
+	name := c.Param("name")
+	var body FeatureFlag
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	featureFlagsLock.Lock()
+	featureFlags[name] = body
+	featureFlagsLock.Unlock()
+	saveFeatureFlags()
+
+	c.JSON(http.StatusOK, gin.H{"flag": name, "enabled": body.Enabled, "rolloutPct": body.RolloutPct})
+}
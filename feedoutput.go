@@ -0,0 +1,166 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// feedOutputHandler serves GET /feed/:keywordAndFormat, rendering a
+// keyword's most recently recorded results as a standards-compliant feed
+// (.rss, .atom or .json) so a feed reader can subscribe to a search
+// instead of a person re-visiting the page. It reads from search history
+// rather than triggering a fresh crawl, so polling it doesn't spend the
+// keyword's News API/YouTube quota on every reader refresh.
+func feedOutputHandler(c *gin.Context) {
+	keyword, format, ok := splitFeedFormat(c.Param("keywordAndFormat"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "feed path must end in .rss, .atom or .json"})
+		return
+	}
+
+	results := redactResults(latestHistoryResults(keyword))
+
+	switch format {
+	case "rss":
+		c.Data(http.StatusOK, "application/rss+xml; charset=utf-8", renderRSSFeed(keyword, results))
+	case "atom":
+		c.Data(http.StatusOK, "application/atom+xml; charset=utf-8", renderAtomFeed(keyword, results))
+	default:
+		c.JSON(http.StatusOK, gin.H{"keyword": keyword, "results": results})
+	}
+}
+
+// splitFeedFormat splits "climate.rss" into ("climate", "rss", true) for a
+// recognized format, or returns ok=false otherwise.
+func splitFeedFormat(keywordAndFormat string) (keyword, format string, ok bool) {
+	for _, ext := range []string{"rss", "atom", "json"} {
+		suffix := "." + ext
+		if strings.HasSuffix(keywordAndFormat, suffix) {
+			return strings.TrimSuffix(keywordAndFormat, suffix), ext, true
+		}
+	}
+	return "", "", false
+}
+
+// latestHistoryResults returns the results from keyword's most recent
+// recorded search, or nil if it has never been searched.
+func latestHistoryResults(keyword string) []FeedResult {
+	appDBLock.Lock()
+	entries := appDB.History[keyword]
+	appDBLock.Unlock()
+
+	if len(entries) == 0 {
+		return nil
+	}
+	return entries[len(entries)-1].Results
+}
+
+type rssFeedXML struct {
+	XMLName xml.Name      `xml:"rss"`
+	Version string        `xml:"version,attr"`
+	Channel rssChannelXML `xml:"channel"`
+}
+
+type rssChannelXML struct {
+	Title       string       `xml:"title"`
+	Link        string       `xml:"link"`
+	Description string       `xml:"description"`
+	Items       []rssItemXML `xml:"item"`
+}
+
+type rssItemXML struct {
+	Title       string `xml:"title"`
+	Link        string `xml:"link"`
+	Description string `xml:"description"`
+	GUID        string `xml:"guid"`
+	PubDate     string `xml:"pubDate,omitempty"`
+}
+
+// renderRSSFeed renders results as an RSS 2.0 document for keyword.
+func renderRSSFeed(keyword string, results []FeedResult) []byte {
+	feed := rssFeedXML{
+		Version: "2.0",
+		Channel: rssChannelXML{
+			Title:       fmt.Sprintf("Social Media Aggregator: %s", keyword),
+			Link:        "/search?keyword=" + keyword,
+			Description: fmt.Sprintf("Aggregated results for %q", keyword),
+		},
+	}
+	for _, result := range results {
+		item := rssItemXML{
+			Title:       result.Title,
+			Link:        result.Link,
+			Description: result.Description,
+			GUID:        result.ID,
+		}
+		if !result.PublishedTime.IsZero() {
+			item.PubDate = result.PublishedTime.Format(time.RFC1123Z)
+		}
+		feed.Channel.Items = append(feed.Channel.Items, item)
+	}
+	return marshalFeedXML(feed)
+}
+
+type atomFeedXML struct {
+	XMLName xml.Name       `xml:"feed"`
+	Xmlns   string         `xml:"xmlns,attr"`
+	Title   string         `xml:"title"`
+	ID      string         `xml:"id"`
+	Updated string         `xml:"updated"`
+	Entries []atomEntryXML `xml:"entry"`
+}
+
+type atomEntryXML struct {
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    atomLinkXML `xml:"link"`
+	Summary string      `xml:"summary"`
+}
+
+type atomLinkXML struct {
+	Href string `xml:"href,attr"`
+}
+
+// renderAtomFeed renders results as an Atom 1.0 document for keyword.
+func renderAtomFeed(keyword string, results []FeedResult) []byte {
+	feed := atomFeedXML{
+		Xmlns:   "http://www.w3.org/2005/Atom",
+		Title:   fmt.Sprintf("Social Media Aggregator: %s", keyword),
+		ID:      "urn:socialmediaaggregator:feed:" + keyword,
+		Updated: time.Now().Format(time.RFC3339),
+	}
+	for _, result := range results {
+		updated := result.PublishedTime
+		if updated.IsZero() {
+			updated = time.Now()
+		}
+		id := result.ID
+		if id == "" {
+			id = result.Link
+		}
+		feed.Entries = append(feed.Entries, atomEntryXML{
+			Title:   result.Title,
+			ID:      "urn:socialmediaaggregator:result:" + id,
+			Updated: updated.Format(time.RFC3339),
+			Link:    atomLinkXML{Href: result.Link},
+			Summary: result.Description,
+		})
+	}
+	return marshalFeedXML(feed)
+}
+
+func marshalFeedXML(v any) []byte {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error marshaling feed XML: %s", err))
+		return nil
+	}
+	return append([]byte(xml.Header), body...)
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// FeedSource is the extension point for adding a new platform to the
+// aggregator without touching fetchAllFeeds: implement Fetch, register an
+// instance in init(), and it starts showing up in every search.
+type FeedSource interface {
+	Name() string
+	Fetch(ctx context.Context, keyword string) ([]FeedResult, error)
+}
+
+// sourceRegistry holds every known FeedSource, keyed by name, plus whether
+// it's currently enabled. Sources default to enabled; use
+// setSourceEnabled to disable one from config without removing its code.
+var (
+	sourceRegistry      = map[string]FeedSource{}
+	sourceRegistryOrder []string
+	sourceEnabled       = map[string]bool{}
+	sourceCategories    = map[string]collectionCategory{}
+)
+
+// collectionCategory classifies how a FeedSource gets its data, so
+// collectionpolicy.go can let an operator forbid whole categories (e.g. "no
+// authenticated scraping") without naming every source individually.
+type collectionCategory string
+
+const (
+	categoryAPI     collectionCategory = "api"
+	categoryRSS     collectionCategory = "rss"
+	categoryScraper collectionCategory = "scraping"
+)
+
+// registerSource adds a FeedSource to the registry under category. It's
+// meant to be called from init() in the file that defines the source.
+func registerSource(source FeedSource, category collectionCategory) {
+	name := source.Name()
+	sourceRegistry[name] = source
+	sourceRegistryOrder = append(sourceRegistryOrder, name)
+	sourceCategories[name] = category
+	sourceEnabled[name] = collectionAllowed(category)
+}
+
+// setSourceEnabled honors enabled unless doing so would violate the active
+// collectionPolicy, in which case the source is refused and left disabled —
+// see collectionpolicy.go. A caller that wants to know whether the request
+// was honored should follow up with isSourceEnabled.
+func setSourceEnabled(name string, enabled bool) {
+	if enabled && !collectionAllowed(sourceCategories[name]) {
+		slog.Warn(fmt.Sprintf("Refusing to enable source %s: its %s collection category is not permitted by the active collection policy", name, sourceCategories[name]))
+		sourceEnabled[name] = false
+		return
+	}
+	sourceEnabled[name] = enabled
+}
+
+func isSourceEnabled(name string) bool {
+	enabled, ok := sourceEnabled[name]
+	return !ok || enabled
+}
+
+// enabledSources returns every registered source that hasn't been disabled,
+// in registration order.
+func enabledSources() []FeedSource {
+	var sources []FeedSource
+	for _, name := range sourceRegistryOrder {
+		if isSourceEnabled(name) {
+			sources = append(sources, sourceRegistry[name])
+		}
+	}
+	return sources
+}
+
+type twitterFeedSource struct{}
+
+func (twitterFeedSource) Name() string { return "Twitter" }
+func (twitterFeedSource) Fetch(ctx context.Context, keyword string) ([]FeedResult, error) {
+	results, err := fetchTwitterFeedsFromHandles(ctx, currentTwitterHandles(), currentTwitterLists(), keyword)
+	if err != nil {
+		return nil, classifySourceError(err)
+	}
+	return results, nil
+}
+
+type youtubeFeedSource struct{}
+
+func (youtubeFeedSource) Name() string { return "YouTube" }
+func (youtubeFeedSource) Fetch(ctx context.Context, keyword string) ([]FeedResult, error) {
+	results := fetchYouTubeFeedsWithCache(ctx, keyword)
+	if raw, ok := snapshotSourceErrors()["YouTube"]; ok {
+		return results, classifySourceError(errors.New(raw))
+	}
+	return results, nil
+}
+
+type instagramFeedSource struct{}
+
+func (instagramFeedSource) Name() string { return "Instagram" }
+func (instagramFeedSource) Fetch(ctx context.Context, keyword string) ([]FeedResult, error) {
+	results, err := fetchInstagramFeeds(ctx, keyword)
+	if err != nil {
+		return nil, classifySourceError(err)
+	}
+	return results, nil
+}
+
+type facebookFeedSource struct{}
+
+func (facebookFeedSource) Name() string { return "Facebook" }
+func (facebookFeedSource) Fetch(ctx context.Context, keyword string) ([]FeedResult, error) {
+	// fetchFacebookFeeds (facebookgraph.go) uses the Graph API by default
+	// and only falls back to chromedp scraping when explicitly opted into,
+	// since scraping is both fragile and against Facebook's terms.
+	return fetchFacebookFeeds(ctx, keyword), nil
+}
+
+type tiktokFeedSource struct{}
+
+func (tiktokFeedSource) Name() string { return "TikTok" }
+func (tiktokFeedSource) Fetch(ctx context.Context, keyword string) ([]FeedResult, error) {
+	// TikTok is still an experimental scraping source; keep it opt-in via
+	// the tiktok_source feature flag rather than running it for everyone
+	// the moment it's registered.
+	if !isFeatureEnabled("tiktok_source", keyword) {
+		return nil, nil
+	}
+	return fetchTikTokFeeds(ctx, keyword), nil
+}
+
+type linkedinFeedSource struct{}
+
+func (linkedinFeedSource) Name() string { return "LinkedIn" }
+func (linkedinFeedSource) Fetch(ctx context.Context, keyword string) ([]FeedResult, error) {
+	return fetchLinkedInFeeds(ctx, keyword), nil
+}
+
+type threadsFeedSource struct{}
+
+func (threadsFeedSource) Name() string { return "Threads" }
+func (threadsFeedSource) Fetch(ctx context.Context, keyword string) ([]FeedResult, error) {
+	return fetchThreadsFeeds(ctx, keyword), nil
+}
+
+type lemmyFeedSource struct{}
+
+func (lemmyFeedSource) Name() string { return "Lemmy" }
+func (lemmyFeedSource) Fetch(ctx context.Context, keyword string) ([]FeedResult, error) {
+	results, err := fetchLemmyFeeds(ctx, keyword)
+	if err != nil {
+		return nil, classifySourceError(err)
+	}
+	return results, nil
+}
+
+type newsAPIFeedSource struct{}
+
+func (newsAPIFeedSource) Name() string { return "NewsAPI" }
+func (newsAPIFeedSource) Fetch(ctx context.Context, keyword string) ([]FeedResult, error) {
+	results := fetchNewsFeedsWithCache(ctx, keyword)
+	if raw, ok := snapshotSourceErrors()["NewsAPI"]; ok {
+		return results, classifySourceError(errors.New(raw))
+	}
+	return results, nil
+}
+
+type rssFeedSource struct{}
+
+func (rssFeedSource) Name() string { return "RSS" }
+func (rssFeedSource) Fetch(ctx context.Context, keyword string) ([]FeedResult, error) {
+	return fetchRSSFeeds(ctx, keyword), nil
+}
+
+func init() {
+	registerSource(twitterFeedSource{}, categoryAPI)
+	registerSource(youtubeFeedSource{}, categoryAPI)
+	registerSource(instagramFeedSource{}, categoryAPI)
+	registerSource(facebookFeedSource{}, categoryAPI)
+	registerSource(tiktokFeedSource{}, categoryScraper)
+	registerSource(linkedinFeedSource{}, categoryScraper)
+	registerSource(threadsFeedSource{}, categoryScraper)
+	registerSource(lemmyFeedSource{}, categoryAPI)
+	registerSource(newsAPIFeedSource{}, categoryAPI)
+	registerSource(rssFeedSource{}, categoryRSS)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+)
+
+// simhash computes a 64-bit simhash fingerprint of text: near-duplicate
+// texts (syndicated copies with minor rewrites) produce fingerprints with a
+// small Hamming distance, unlike a plain content hash which changes
+// completely for a single edited word.
+func simhash(text string) uint64 {
+	var weights [64]int
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		h := fnv.New64a()
+		h.Write([]byte(word))
+		hash := h.Sum64()
+		for bit := 0; bit < 64; bit++ {
+			if hash&(1<<uint(bit)) != 0 {
+				weights[bit]++
+			} else {
+				weights[bit]--
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for bit := 0; bit < 64; bit++ {
+		if weights[bit] > 0 {
+			fingerprint |= 1 << uint(bit)
+		}
+	}
+	return fingerprint
+}
+
+// hammingDistance64 counts the differing bits between two fingerprints.
+func hammingDistance64(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// simhashSyndicationThreshold is the maximum Hamming distance at which two
+// fingerprints are considered the same syndicated story.
+const simhashSyndicationThreshold = 3
+
+// annotateFingerprints computes each result's fingerprint, groups
+// near-duplicates into syndication clusters, and attributes each cluster to
+// whichever source published it first ("broken by" scoop attribution).
+func annotateFingerprints(results []FeedResult) []FeedResult {
+	for i := range results {
+		results[i].Fingerprint = simhash(results[i].Title + " " + results[i].Description)
+	}
+
+	// clusters holds, for each cluster's representative fingerprint, the
+	// indexes of every result belonging to it.
+	var clusters [][]int
+	for i := range results {
+		placed := false
+		for c, cluster := range clusters {
+			if hammingDistance64(results[i].Fingerprint, results[cluster[0]].Fingerprint) <= simhashSyndicationThreshold {
+				clusters[c] = append(cluster, i)
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			clusters = append(clusters, []int{i})
+		}
+	}
+
+	for _, cluster := range clusters {
+		if len(cluster) < 2 {
+			continue
+		}
+		first := cluster[0]
+		for _, idx := range cluster[1:] {
+			if results[idx].PublishedTime.Before(results[first].PublishedTime) {
+				first = idx
+			}
+		}
+		for _, idx := range cluster {
+			if idx != first {
+				results[idx].Syndicated = true
+			}
+			results[idx].BrokenBy = results[first].Source
+		}
+	}
+
+	return results
+}
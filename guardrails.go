@@ -0,0 +1,47 @@
+package main
+
+import "strings"
+
+// minKeywordLength is the shortest keyword accepted without opting into
+// broad-query mode.
+const minKeywordLength = 3
+
+// stopWords are generic terms that flood the aggregator with irrelevant
+// results if searched without further narrowing.
+var stopWords = map[string]bool{
+	"news":  true,
+	"today": true,
+	"the":   true,
+	"world": true,
+	"live":  true,
+}
+
+// queryGuardrailResult describes why a keyword needs broad-query mode, if at
+// all. Warning is non-empty for stop words even when the search is allowed.
+type queryGuardrailResult struct {
+	Blocked bool
+	Warning string
+}
+
+// checkQueryGuardrails validates a keyword against the minimum length and
+// stop-word rules. broadMode bypasses the length/stop-word block, but the
+// caller is still expected to have supplied a source subset or date filter
+// before setting it.
+func checkQueryGuardrails(keyword string, broadMode bool) queryGuardrailResult {
+	trimmed := strings.TrimSpace(keyword)
+	isStopWord := stopWords[strings.ToLower(trimmed)]
+	tooShort := len(trimmed) < minKeywordLength
+
+	if !broadMode && (tooShort || isStopWord) {
+		return queryGuardrailResult{
+			Blocked: true,
+			Warning: "keyword is too short or too generic; retry with broadMode=1 and a source subset or date filter",
+		}
+	}
+
+	if isStopWord {
+		return queryGuardrailResult{Warning: "keyword is a common stop word and may return a very broad result set"}
+	}
+
+	return queryGuardrailResult{}
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// historyEntry is one crawl's results for a keyword, stored in the embedded
+// database so /history?keyword=x can show what was found in previous
+// searches without re-crawling.
+type historyEntry struct {
+	CrawledAt time.Time    `json:"crawledAt"`
+	Results   []FeedResult `json:"results"`
+}
+
+// maxHistoryEntriesPerKeyword bounds how many past crawls are kept per
+// keyword so app.db.json doesn't grow without limit.
+const maxHistoryEntriesPerKeyword = 20
+
+// recordSearchHistory appends a crawl snapshot for keyword to the embedded
+// database, trimming to the most recent maxHistoryEntriesPerKeyword.
+// Ingestion is idempotent by result ID set: overlapping or retried crawls
+// that produced the exact same results as the last recorded crawl don't
+// insert a second identical entry.
+func recordSearchHistory(keyword string, results map[string][]FeedResult) {
+	var flattened []FeedResult
+	for _, sourceResults := range results {
+		flattened = append(flattened, sourceResults...)
+	}
+	flattened = redactResults(flattened)
+
+	appDBLock.Lock()
+	if appDB.History == nil {
+		appDB.History = make(map[string][]historyEntry)
+	}
+	existing := appDB.History[keyword]
+	if len(existing) > 0 && sameResultIDs(existing[len(existing)-1].Results, flattened) {
+		appDBLock.Unlock()
+		return
+	}
+
+	entries := append(existing, historyEntry{CrawledAt: time.Now(), Results: flattened})
+	if len(entries) > maxHistoryEntriesPerKeyword {
+		entries = entries[len(entries)-maxHistoryEntriesPerKeyword:]
+	}
+	appDB.History[keyword] = entries
+	appDBLock.Unlock()
+
+	if err := saveAppDB(); err != nil {
+		slog.Error(fmt.Sprintf("Error saving search history: %s", err))
+	}
+}
+
+// sameResultIDs reports whether a and b contain exactly the same set of
+// result IDs, regardless of order.
+func sameResultIDs(a, b []FeedResult) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ids := make(map[string]bool, len(a))
+	for _, result := range a {
+		ids[result.ID] = true
+	}
+	for _, result := range b {
+		if !ids[result.ID] {
+			return false
+		}
+	}
+	return true
+}
+
+// newSinceLastSearch returns the results in latest that weren't present in
+// the crawl before it (matched by link), so the UI can badge "new since
+// last search" without the client having to diff itself.
+func newSinceLastSearch(keyword string) []FeedResult {
+	appDBLock.Lock()
+	entries := appDB.History[keyword]
+	appDBLock.Unlock()
+
+	if len(entries) < 2 {
+		return nil
+	}
+
+	previous := entries[len(entries)-2]
+	latest := entries[len(entries)-1]
+
+	seen := make(map[string]bool, len(previous.Results))
+	for _, result := range previous.Results {
+		seen[result.Link] = true
+	}
+
+	var fresh []FeedResult
+	for _, result := range latest.Results {
+		if !seen[result.Link] {
+			fresh = append(fresh, result)
+		}
+	}
+	return fresh
+}
+
+func historyHandler(c *gin.Context) {
+	keyword := c.Query("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is required"})
+		return
+	}
+
+	appDBLock.Lock()
+	entries := appDB.History[keyword]
+	appDBLock.Unlock()
+
+	c.JSON(http.StatusOK, gin.H{
+		"keyword":         keyword,
+		"history":         entries,
+		"newSinceLastRun": newSinceLastSearch(keyword),
+	})
+}
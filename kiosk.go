@@ -0,0 +1,43 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// kioskRotationInterval is how long each keyword is shown before the kiosk
+// view auto-refreshes to the next one.
+const kioskRotationInterval = 15 * time.Second
+
+// kioskHandler renders the /kiosk view: the top items for one watched
+// keyword at a time, cycling automatically. It only reads from the hot
+// cache so it never triggers upstream API calls on its own, making it safe
+// to leave running on a newsroom wall display.
+func kioskHandler(c *gin.Context) {
+	searchedKeywordsLock.Lock()
+	keywords := sortKeywordsByCount(searchedKeywords)
+	searchedKeywordsLock.Unlock()
+
+	if len(keywords) == 0 {
+		c.HTML(http.StatusOK, "kiosk.html", gin.H{"empty": true})
+		return
+	}
+
+	// The slot rotates purely as a function of wall-clock time, so every
+	// client viewing the kiosk stays in sync without any shared state.
+	slot := int(time.Now().Unix()/int64(kioskRotationInterval.Seconds())) % len(keywords)
+	keyword := keywords[slot]
+
+	var items []FeedResult
+	if cached, ok := cache.Load("news:" + keyword); ok {
+		items = cached.([]FeedResult)
+	}
+
+	c.HTML(http.StatusOK, "kiosk.html", gin.H{
+		"keyword":        keyword,
+		"items":          items,
+		"refreshSeconds": int(kioskRotationInterval.Seconds()),
+	})
+}
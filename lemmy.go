@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// lemmyInstanceURL is the Lemmy instance searched for keyword matches.
+// Lemmy is federated: any instance's public search API also returns
+// results federated in from other instances it's connected to, so one
+// configured instance gives broader-than-single-server coverage without
+// needing per-instance credentials.
+var lemmyInstanceURL = "https://lemmy.world"
+
+func loadLemmyConfig() {
+	if raw := os.Getenv("LEMMY_INSTANCE_URL"); raw != "" {
+		lemmyInstanceURL = raw
+	}
+}
+
+type lemmySearchResponse struct {
+	Posts []struct {
+		Post struct {
+			ID           int    `json:"id"`
+			Name         string `json:"name"`
+			Body         string `json:"body"`
+			URL          string `json:"url"`
+			ApID         string `json:"ap_id"`
+			Published    string `json:"published"`
+			ThumbnailURL string `json:"thumbnail_url"`
+		} `json:"post"`
+		Community struct {
+			Name string `json:"name"`
+		} `json:"community"`
+	} `json:"posts"`
+	Comments []struct {
+		Comment struct {
+			ID        int    `json:"id"`
+			Content   string `json:"content"`
+			ApID      string `json:"ap_id"`
+			Published string `json:"published"`
+		} `json:"comment"`
+		Post struct {
+			Name string `json:"name"`
+		} `json:"post"`
+	} `json:"comments"`
+}
+
+// fetchLemmyFeeds queries lemmyInstanceURL's search API for keyword,
+// normalizing both matching posts and matching comments into FeedResult —
+// the fediverse counterpart to Reddit's "new posts + top comments" shape
+// in redditwatch.go, but keyword-driven like every other FeedSource
+// instead of subreddit-watch-driven.
+func fetchLemmyFeeds(ctx context.Context, keyword string) ([]FeedResult, error) {
+	searchURL := fmt.Sprintf("%s/api/v3/search?q=%s&type_=All&sort=New&limit=20",
+		lemmyInstanceURL, url.QueryEscape(keyword))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, searchURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error querying Lemmy instance %s: %w", lemmyInstanceURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("lemmy search request failed with status %d", resp.StatusCode)
+	}
+
+	var parsed lemmySearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("error decoding Lemmy search response: %w", err)
+	}
+
+	var results []FeedResult
+	for _, item := range parsed.Posts {
+		published, err := time.Parse(time.RFC3339, item.Post.Published)
+		if err != nil {
+			published = time.Now()
+		}
+		link := item.Post.ApID
+		if link == "" {
+			link = item.Post.URL
+		}
+		results = append(results, FeedResult{
+			ID:            fmt.Sprintf("post-%d", item.Post.ID),
+			Title:         fmt.Sprintf("[%s] %s", item.Community.Name, item.Post.Name),
+			Link:          link,
+			Published:     published.Format("2006-01-02 15:04:05"),
+			PublishedTime: published,
+			Description:   item.Post.Body,
+			Source:        "Lemmy",
+			Thumbnail:     item.Post.ThumbnailURL,
+		})
+	}
+	for _, item := range parsed.Comments {
+		published, err := time.Parse(time.RFC3339, item.Comment.Published)
+		if err != nil {
+			published = time.Now()
+		}
+		results = append(results, FeedResult{
+			ID:            fmt.Sprintf("comment-%d", item.Comment.ID),
+			Title:         fmt.Sprintf("Comment on %q", item.Post.Name),
+			Link:          item.Comment.ApID,
+			Published:     published.Format("2006-01-02 15:04:05"),
+			PublishedTime: published,
+			Description:   item.Comment.Content,
+			Source:        "Lemmy",
+		})
+	}
+	return results, nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/chromedp"
+	"github.com/gin-gonic/gin"
+)
+
+// This repo has no separate "crawler server" process for chromedp jobs to
+// run on (see the note atop crawljobs.go) — LinkedIn crawling runs
+// in-process the same way the Facebook and Instagram scrape fallbacks do,
+// exposed as POST /crawl/linkedin rather than folded into the generic
+// /crawl/jobs endpoint, since a LinkedIn crawl optionally needs a session
+// cookie that other sources don't.
+
+// fetchLinkedInFeeds scrapes LinkedIn's public content search for keyword.
+// LinkedIn's search results are mostly blank without an authenticated
+// session, so LinkedInSessionCookie (config.go), if configured, is
+// attached as the li_at cookie before navigating; without it, only the
+// small amount of content LinkedIn renders for logged-out visitors is
+// returned.
+func fetchLinkedInFeeds(ctx context.Context, keyword string) []FeedResult {
+	searchURL := "https://www.linkedin.com/search/results/content/?keywords=" + url.QueryEscape(keyword)
+
+	ctx, cancel := chromedp.NewContext(ctx)
+	defer cancel()
+
+	tasks := chromedp.Tasks{}
+	if appConfig.LinkedInSessionCookie != "" {
+		tasks = append(tasks, chromedp.ActionFunc(func(ctx context.Context) error {
+			return network.SetCookie("li_at", appConfig.LinkedInSessionCookie).
+				WithDomain(".linkedin.com").
+				WithPath("/").
+				WithHTTPOnly(true).
+				WithSecure(true).
+				Do(ctx)
+		}))
+	}
+	tasks = append(tasks,
+		chromedp.Navigate(searchURL),
+		chromedp.Sleep(3*time.Second),
+	)
+
+	var htmlContent string
+	tasks = append(tasks, chromedp.OuterHTML("body", &htmlContent))
+
+	if err := chromedp.Run(ctx, tasks); err != nil {
+		slog.Error(fmt.Sprintf("Error scraping LinkedIn search for %q: %s", keyword, err))
+		return nil
+	}
+
+	return parseLinkedInSearchPage(htmlContent, keyword)
+}
+
+// parseLinkedInSearchPage extracts post links from a rendered LinkedIn
+// search-results page, the same link-only fallback shape
+// parseInstagramHashtagPage uses: LinkedIn's DOM doesn't expose a stable
+// caption/author selector without an authenticated session either.
+func parseLinkedInSearchPage(htmlContent, keyword string) []FeedResult {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlContent))
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error parsing LinkedIn search page: %s", err))
+		return nil
+	}
+
+	var results []FeedResult
+	seen := make(map[string]bool)
+	doc.Find(`a[href*="/posts/"], a[href*="/feed/update/"]`).Each(func(_ int, sel *goquery.Selection) {
+		href, ok := sel.Attr("href")
+		if !ok || seen[href] {
+			return
+		}
+		seen[href] = true
+
+		link := href
+		if strings.HasPrefix(link, "/") {
+			link = "https://www.linkedin.com" + link
+		}
+
+		results = append(results, FeedResult{
+			Title:         fmt.Sprintf("LinkedIn post matching %q", keyword),
+			Link:          link,
+			Published:     time.Now().Format("2006-01-02 15:04:05"),
+			PublishedTime: time.Now(),
+			Source:        "LinkedIn",
+		})
+	})
+	return results
+}
+
+func crawlLinkedInHandler(c *gin.Context) {
+	keyword := c.PostForm("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is required"})
+		return
+	}
+	if rejectSearchInPublicMode(c, keyword) {
+		return
+	}
+	job := crawlJobs.Submit(keyword, "LinkedIn")
+	c.JSON(http.StatusAccepted, job)
+}
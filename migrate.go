@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+)
+
+// runMigrateCommand imports searched_keywords.json, twitterhandles.json and
+// news_sources.json into the embedded database and verifies the import by
+// reloading the database and comparing record counts.
+func runMigrateCommand() error {
+	if err := loadAppDB(); err != nil {
+		return err
+	}
+
+	keywords, err := readLegacyKeywords("searched_keywords.json")
+	if err != nil {
+		slog.Info(fmt.Sprintf("Skipping keyword migration: %s", err))
+	} else {
+		for keyword, count := range keywords {
+			appDB.Keywords[keyword] = count
+		}
+	}
+
+	handles := loadTwitterHandles()
+	appDB.TwitterHandles = handles
+
+	sources, err := loadNewsSources("news_sources.json")
+	if err != nil {
+		slog.Info(fmt.Sprintf("Skipping source migration: %s", err))
+	} else {
+		appDB.Sources = sources
+	}
+
+	if err := saveAppDB(); err != nil {
+		return err
+	}
+
+	return verifyMigration(len(keywords), len(handles), len(sources))
+}
+
+func readLegacyKeywords(path string) (map[string]int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening %s: %w", path, err)
+	}
+	defer file.Close()
+
+	keywords := make(map[string]int)
+	if err := json.NewDecoder(file).Decode(&keywords); err != nil {
+		return nil, fmt.Errorf("error decoding %s: %w", path, err)
+	}
+	return keywords, nil
+}
+
+// verifyMigration reloads the database from disk and checks that the record
+// counts match what was imported, so a partial write doesn't go unnoticed.
+func verifyMigration(wantKeywords, wantHandles, wantSources int) error {
+	var reloaded appDatabase
+	file, err := os.Open(dbFile)
+	if err != nil {
+		return fmt.Errorf("error reopening database for verification: %w", err)
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&reloaded); err != nil {
+		return fmt.Errorf("error decoding database for verification: %w", err)
+	}
+
+	if len(reloaded.Keywords) != wantKeywords || len(reloaded.TwitterHandles) != wantHandles || len(reloaded.Sources) != wantSources {
+		return fmt.Errorf("migration verification failed: got %d keywords, %d handles, %d sources; want %d, %d, %d",
+			len(reloaded.Keywords), len(reloaded.TwitterHandles), len(reloaded.Sources), wantKeywords, wantHandles, wantSources)
+	}
+
+	slog.Info(fmt.Sprintf("Migration verified: %d keywords, %d handles, %d sources", len(reloaded.Keywords), len(reloaded.TwitterHandles), len(reloaded.Sources)))
+	return nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+
+	"golang.org/x/net/proxy"
+)
+
+// sharedTransport is the base http.RoundTripper for every outbound request
+// the aggregator makes (NewsAPI, YouTube, Twitter, RSS, OAuth2 token
+// exchanges). Building it once lets a single set of environment variables
+// route the whole process through a corporate proxy or trust a private CA,
+// instead of every fetcher needing its own transport configuration.
+var sharedTransport http.RoundTripper = buildSharedTransport()
+
+// buildSharedTransport reads HTTP_PROXY/HTTPS_PROXY/NO_PROXY (standard Go
+// env-based proxy resolution), an optional ALL_PROXY=socks5://... for SOCKS
+// proxies, and an optional CORPORATE_CA_FILE PEM bundle to trust in addition
+// to the system root CAs.
+func buildSharedTransport() http.RoundTripper {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	transport.DialContext = dialContextWithDNSOverride(buildSharedDialer())
+
+	if caFile := os.Getenv("CORPORATE_CA_FILE"); caFile != "" {
+		pool, err := loadCorporateCAPool(caFile)
+		if err != nil {
+			slog.Error(fmt.Sprintf("Warning: failed to load CORPORATE_CA_FILE %q: %s", caFile, err))
+		} else {
+			transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+		}
+	}
+
+	if socksAddr := os.Getenv("ALL_PROXY"); socksAddr != "" {
+		// Forward through the same DNS-override dialer used above, so the
+		// connection to the SOCKS5 proxy itself still honors DNS_SERVER/DoH
+		// instead of falling back to net.Dialer's plain resolution.
+		forward := dialContextFunc(dialContextWithDNSOverride(buildSharedDialer()))
+		dialer, err := proxy.SOCKS5("tcp", socksAddr, nil, forward)
+		if err != nil {
+			slog.Error(fmt.Sprintf("Warning: failed to configure SOCKS5 proxy %q: %s", socksAddr, err))
+		} else if contextDialer, ok := dialer.(proxy.ContextDialer); ok {
+			transport.DialContext = contextDialer.DialContext
+		} else {
+			slog.Error(fmt.Sprintf("Warning: SOCKS5 proxy %q dialer does not support DialContext", socksAddr))
+		}
+	}
+
+	return &limitingRoundTripper{Base: &retryingRoundTripper{Base: transport}}
+}
+
+// dialContextFunc adapts a DialContext-shaped function to proxy.Dialer, so
+// it can be passed as a SOCKS5 forward dialer (which dials the proxy server
+// itself, not the ultimate destination).
+type dialContextFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+func (f dialContextFunc) Dial(network, addr string) (net.Conn, error) {
+	return f(context.Background(), network, addr)
+}
+
+// loadCorporateCAPool reads a PEM-encoded CA bundle and appends it to a
+// copy of the system trust store, so private feeds behind a corporate MITM
+// proxy or self-signed enterprise API are trusted alongside public CAs.
+func loadCorporateCAPool(caFile string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading CA file: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no valid certificates found in %s", caFile)
+	}
+
+	return pool, nil
+}
+
+// sharedHTTPClient is a ready-to-use *http.Client wired to sharedTransport,
+// for callers that don't need any additional per-request auth.
+var sharedHTTPClient = &http.Client{Transport: sharedTransport}
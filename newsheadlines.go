@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// fetchNewsHeadlines calls NewsAPI's /v2/top-headlines endpoint, the
+// curated-headlines counterpart to fetchNewsFeeds' keyword search against
+// /v2/everything. category, country and sources are passed straight
+// through when set; NewsAPI itself rejects a request that sets both
+// sources and either category or country, so that validation is left to
+// the API rather than duplicated here.
+func fetchNewsHeadlines(ctx context.Context, category, country, sources string) ([]FeedResult, error) {
+	apiKey := appConfig.NewsAPIKey
+	if apiKey == "" {
+		return nil, fmt.Errorf("NEWS_API_KEY environment variable is not set")
+	}
+
+	baseURL := "https://newsapi.org/v2/top-headlines"
+	params := url.Values{"apiKey": {apiKey}}
+	if category != "" {
+		params.Set("category", category)
+	}
+	if country != "" {
+		params.Set("country", country)
+	}
+	if sources != "" {
+		params.Set("sources", sources)
+	}
+	urlStr := baseURL + "?" + params.Encode()
+
+	slog.Info(fmt.Sprintf("Fetching top headlines from URL: %s", baseURL))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error building top headlines request: %w", err)
+	}
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching top headlines: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err := parseNewsAPIError(resp)
+		recordSourceError("NewsAPI", err)
+		return nil, err
+	}
+	recordSourceError("NewsAPI", nil)
+
+	var apiResponse struct {
+		Articles []struct {
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			URL         string `json:"url"`
+			PublishedAt string `json:"publishedAt"`
+			Source      struct {
+				Name string `json:"name"`
+			} `json:"source"`
+			URLToImage string `json:"urlToImage"`
+		} `json:"articles"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return nil, fmt.Errorf("error decoding top headlines response: %w", err)
+	}
+
+	slog.Info(fmt.Sprintf("Top headlines returned %d articles", len(apiResponse.Articles)))
+	recordCost("newsapi_call", "headlines:"+category+":"+country, 1)
+
+	var results []FeedResult
+	for _, article := range apiResponse.Articles {
+		published, _ := time.Parse(time.RFC3339, article.PublishedAt)
+		results = append(results, FeedResult{
+			Title:         article.Title,
+			Link:          article.URL,
+			Published:     published.Format("2006-01-02 15:04:05"),
+			PublishedTime: published,
+			Description:   article.Description,
+			Source:        article.Source.Name,
+			Thumbnail:     article.URLToImage,
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].PublishedTime.After(results[j].PublishedTime)
+	})
+
+	return results, nil
+}
+
+// newsHeadlinesHandler serves GET /news/headlines?category=&country=&sources=,
+// NewsAPI's curated top-headlines mode, alongside the keyword-driven
+// /news endpoint that queries /v2/everything.
+func newsHeadlinesHandler(c *gin.Context) {
+	category := c.Query("category")
+	country := c.Query("country")
+	sources := c.Query("sources")
+	if category == "" && country == "" && sources == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "at least one of category, country, or sources is required"})
+		return
+	}
+	// Curated headlines aren't keyed to a watched keyword the way the rest
+	// of publicReadOnlyMode's exemptions are, so there's no "already known"
+	// case to allow through — public mode blocks this endpoint outright.
+	if rejectSearchInPublicMode(c, "") {
+		return
+	}
+
+	results, err := fetchNewsHeadlines(c.Request.Context(), category, country, sources)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"results": results, "totalResults": len(results)})
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// newsProvider abstracts fetchNewsFeedsWithCache's API-backed news source,
+// so a deployment without a NewsAPI key (or one that has hit its 100/day
+// free-tier limit) can point at GNews, Bing News, or Mediastack instead
+// without touching any of the caching, dedup, or enrichment code built
+// around FeedResult.
+type newsProvider interface {
+	Name() string
+	FetchArticles(ctx context.Context, keyword string) ([]FeedResult, error)
+}
+
+// activeNewsProvider returns the provider named by NEWS_API_PROVIDER,
+// defaulting to NewsAPI itself when unset or unrecognized — the same
+// permissive-default approach googleNewsRSSURL uses for an unknown region.
+func activeNewsProvider() newsProvider {
+	switch appConfig.NewsAPIProvider {
+	case "gnews":
+		return gNewsProvider{}
+	case "bing":
+		return bingNewsProvider{}
+	case "mediastack":
+		return mediastackProvider{}
+	default:
+		return newsAPIProvider{}
+	}
+}
+
+// newsAPIProvider wraps the pre-existing NewsAPI integration so it fits the
+// same interface as the newer providers below. fetchNewsFeeds already logs
+// and records its own errors (see recordSourceError("NewsAPI", ...)), so
+// this never returns a non-nil error itself.
+type newsAPIProvider struct{}
+
+func (newsAPIProvider) Name() string { return "NewsAPI" }
+func (newsAPIProvider) FetchArticles(ctx context.Context, keyword string) ([]FeedResult, error) {
+	return fetchNewsFeeds(ctx, keyword), nil
+}
+
+// gNewsProvider fetches from GNews' /api/v4/search endpoint.
+type gNewsProvider struct{}
+
+func (gNewsProvider) Name() string { return "GNews" }
+func (gNewsProvider) FetchArticles(ctx context.Context, keyword string) ([]FeedResult, error) {
+	apiKey := appConfig.GNewsAPIKey
+	if apiKey == "" {
+		return nil, fmt.Errorf("GNEWS_API_KEY environment variable is not set")
+	}
+
+	urlStr := fmt.Sprintf("https://gnews.io/api/v4/search?q=%s&lang=en&apikey=%s", url.QueryEscape(keyword), apiKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error building GNews request: %w", err)
+	}
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching GNews feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("GNews returned status %d", resp.StatusCode)
+		recordSourceError("GNews", err)
+		return nil, err
+	}
+	recordSourceError("GNews", nil)
+
+	var apiResponse struct {
+		Articles []struct {
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			URL         string `json:"url"`
+			PublishedAt string `json:"publishedAt"`
+			Image       string `json:"image"`
+			Source      struct {
+				Name string `json:"name"`
+			} `json:"source"`
+		} `json:"articles"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return nil, fmt.Errorf("error decoding GNews response: %w", err)
+	}
+
+	recordCost("newsapi_call", keyword, 1)
+
+	var results []FeedResult
+	for _, article := range apiResponse.Articles {
+		published, _ := time.Parse(time.RFC3339, article.PublishedAt)
+		results = append(results, FeedResult{
+			Title:         article.Title,
+			Link:          article.URL,
+			Published:     published.Format("2006-01-02 15:04:05"),
+			PublishedTime: published,
+			Description:   article.Description,
+			Source:        article.Source.Name,
+			Thumbnail:     article.Image,
+		})
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].PublishedTime.After(results[j].PublishedTime) })
+
+	slog.Info(fmt.Sprintf("GNews returned %d articles", len(results)))
+	return results, nil
+}
+
+// bingNewsProvider fetches from the Bing News Search API's /v7.0/news/search
+// endpoint, authenticated via the Ocp-Apim-Subscription-Key header rather
+// than a query parameter.
+type bingNewsProvider struct{}
+
+func (bingNewsProvider) Name() string { return "BingNews" }
+func (bingNewsProvider) FetchArticles(ctx context.Context, keyword string) ([]FeedResult, error) {
+	apiKey := appConfig.BingNewsAPIKey
+	if apiKey == "" {
+		return nil, fmt.Errorf("BING_NEWS_API_KEY environment variable is not set")
+	}
+
+	urlStr := "https://api.bing.microsoft.com/v7.0/news/search?q=" + url.QueryEscape(keyword)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error building Bing News request: %w", err)
+	}
+	req.Header.Set("Ocp-Apim-Subscription-Key", apiKey)
+
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching Bing News feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("Bing News returned status %d", resp.StatusCode)
+		recordSourceError("BingNews", err)
+		return nil, err
+	}
+	recordSourceError("BingNews", nil)
+
+	var apiResponse struct {
+		Value []struct {
+			Name          string `json:"name"`
+			Description   string `json:"description"`
+			URL           string `json:"url"`
+			DatePublished string `json:"datePublished"`
+			Provider      []struct {
+				Name string `json:"name"`
+			} `json:"provider"`
+			Image struct {
+				Thumbnail struct {
+					ContentURL string `json:"contentUrl"`
+				} `json:"thumbnail"`
+			} `json:"image"`
+		} `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return nil, fmt.Errorf("error decoding Bing News response: %w", err)
+	}
+
+	recordCost("newsapi_call", keyword, 1)
+
+	var results []FeedResult
+	for _, article := range apiResponse.Value {
+		published, _ := time.Parse(time.RFC3339, article.DatePublished)
+		source := "Bing News"
+		if len(article.Provider) > 0 {
+			source = article.Provider[0].Name
+		}
+		results = append(results, FeedResult{
+			Title:         article.Name,
+			Link:          article.URL,
+			Published:     published.Format("2006-01-02 15:04:05"),
+			PublishedTime: published,
+			Description:   article.Description,
+			Source:        source,
+			Thumbnail:     article.Image.Thumbnail.ContentURL,
+		})
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].PublishedTime.After(results[j].PublishedTime) })
+
+	slog.Info(fmt.Sprintf("Bing News returned %d articles", len(results)))
+	return results, nil
+}
+
+// mediastackProvider fetches from Mediastack's /v1/news endpoint.
+type mediastackProvider struct{}
+
+func (mediastackProvider) Name() string { return "Mediastack" }
+func (mediastackProvider) FetchArticles(ctx context.Context, keyword string) ([]FeedResult, error) {
+	apiKey := appConfig.MediastackAPIKey
+	if apiKey == "" {
+		return nil, fmt.Errorf("MEDIASTACK_API_KEY environment variable is not set")
+	}
+
+	urlStr := fmt.Sprintf("http://api.mediastack.com/v1/news?access_key=%s&keywords=%s&languages=en", apiKey, url.QueryEscape(keyword))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error building Mediastack request: %w", err)
+	}
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching Mediastack feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("Mediastack returned status %d", resp.StatusCode)
+		recordSourceError("Mediastack", err)
+		return nil, err
+	}
+	recordSourceError("Mediastack", nil)
+
+	var apiResponse struct {
+		Data []struct {
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			URL         string `json:"url"`
+			PublishedAt string `json:"published_at"`
+			Source      string `json:"source"`
+			Image       string `json:"image"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
+		return nil, fmt.Errorf("error decoding Mediastack response: %w", err)
+	}
+
+	recordCost("newsapi_call", keyword, 1)
+
+	var results []FeedResult
+	for _, article := range apiResponse.Data {
+		published, _ := time.Parse(time.RFC3339, article.PublishedAt)
+		results = append(results, FeedResult{
+			Title:         article.Title,
+			Link:          article.URL,
+			Published:     published.Format("2006-01-02 15:04:05"),
+			PublishedTime: published,
+			Description:   article.Description,
+			Source:        article.Source,
+			Thumbnail:     article.Image,
+		})
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].PublishedTime.After(results[j].PublishedTime) })
+
+	slog.Info(fmt.Sprintf("Mediastack returned %d articles", len(results)))
+	return results, nil
+}
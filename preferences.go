@@ -0,0 +1,121 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// defaultResultsPerPage is used for a viewer with no stored preferences, or
+// a stored ResultsPerPage of 0.
+const defaultResultsPerPage = 20
+
+// UserPreferences lets a viewer set their own results-per-page and which
+// source panes render collapsed by default, instead of every viewer getting
+// the same layout. Zero values mean "use the default": ResultsPerPage falls
+// back to defaultResultsPerPage, and an empty CollapsedGroups leaves every
+// pane expanded.
+type UserPreferences struct {
+	ResultsPerPage  int      `json:"resultsPerPage,omitempty"`
+	CollapsedGroups []string `json:"collapsedGroups,omitempty"`
+}
+
+// viewerID identifies the caller for preference lookup. There's no
+// session/auth system yet, so like viewerRole (roles.go) the ID is passed
+// explicitly per request via header or query param.
+func viewerID(c *gin.Context) string {
+	id := c.GetHeader("X-Viewer-ID")
+	if id == "" {
+		id = c.Query("viewerId")
+	}
+	return id
+}
+
+// preferencesFor returns viewer's stored preferences, or the zero value
+// (which effectivePreferences resolves to defaults) if none are stored.
+func preferencesFor(viewer string) UserPreferences {
+	if viewer == "" {
+		return UserPreferences{}
+	}
+
+	appDBLock.Lock()
+	defer appDBLock.Unlock()
+
+	return appDB.Preferences[viewer]
+}
+
+// effectiveResultsPerPage resolves prefs.ResultsPerPage against
+// defaultResultsPerPage, so callers never have to special-case zero.
+func effectiveResultsPerPage(prefs UserPreferences) int {
+	if prefs.ResultsPerPage > 0 {
+		return prefs.ResultsPerPage
+	}
+	return defaultResultsPerPage
+}
+
+// collapsedGroupSet turns prefs.CollapsedGroups into a set for cheap
+// template lookups via {{ index .collapsedGroups "Twitter" }}.
+func collapsedGroupSet(prefs UserPreferences) map[string]bool {
+	set := make(map[string]bool, len(prefs.CollapsedGroups))
+	for _, group := range prefs.CollapsedGroups {
+		set[group] = true
+	}
+	return set
+}
+
+func preferencesHandler(c *gin.Context) {
+	viewer := viewerID(c)
+	if viewer == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "viewer id is required (X-Viewer-ID header or viewerId query param)"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"viewerId": viewer, "preferences": preferencesFor(viewer)})
+}
+
+func setPreferencesHandler(c *gin.Context) {
+	viewer := viewerID(c)
+	if viewer == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "viewer id is required (X-Viewer-ID header or viewerId query param)"})
+		return
+	}
+
+	var prefs UserPreferences
+	if err := c.ShouldBindJSON(&prefs); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	appDBLock.Lock()
+	if appDB.Preferences == nil {
+		appDB.Preferences = make(map[string]UserPreferences)
+	}
+	appDB.Preferences[viewer] = prefs
+	appDBLock.Unlock()
+
+	if err := saveAppDB(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"viewerId": viewer, "preferences": prefs})
+}
+
+func deletePreferencesHandler(c *gin.Context) {
+	viewer := viewerID(c)
+	if viewer == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "viewer id is required (X-Viewer-ID header or viewerId query param)"})
+		return
+	}
+
+	appDBLock.Lock()
+	delete(appDB.Preferences, viewer)
+	appDBLock.Unlock()
+
+	if err := saveAppDB(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
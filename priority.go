@@ -0,0 +1,125 @@
+package main
+
+import "context"
+
+// keywordPriorityContextKey is the context key a search's resolved
+// keyword priority is stashed under, mirroring sourceGroupContextKey
+// (sourcetags.go) — fetchAllFeeds and enrichSearchResults read it back to
+// decide source selection and enrichment depth without either needing an
+// extra parameter threaded through every call in between.
+type keywordPriorityContextKey struct{}
+
+// withKeywordPriority returns a copy of ctx carrying priority. "normal"
+// (the zero value once normalized) isn't stashed, since it's also
+// priorityFromContext's default for a ctx that never set one.
+func withKeywordPriority(ctx context.Context, priority keywordPriority) context.Context {
+	priority = normalizePriority(priority)
+	if priority == priorityNormal {
+		return ctx
+	}
+	return context.WithValue(ctx, keywordPriorityContextKey{}, priority)
+}
+
+// priorityFromContext returns the priority stashed by withKeywordPriority,
+// or "normal" if the request never set one.
+func priorityFromContext(ctx context.Context) keywordPriority {
+	priority, _ := ctx.Value(keywordPriorityContextKey{}).(keywordPriority)
+	return normalizePriority(priority)
+}
+
+// keywordPriority controls how aggressively the scheduler (scheduler.go)
+// polls a watched keyword: "critical" keywords are crawled most often and
+// keep running even as quota tightens; "low" keywords are the first thing
+// skipped when quota is scarce.
+type keywordPriority string
+
+const (
+	priorityCritical keywordPriority = "critical"
+	priorityNormal   keywordPriority = "normal"
+	priorityLow      keywordPriority = "low"
+)
+
+// normalizePriority defaults an unset or unrecognized value to "normal",
+// the same "empty means default" convention WatchlistEntry's other fields
+// use.
+func normalizePriority(p keywordPriority) keywordPriority {
+	switch p {
+	case priorityCritical, priorityLow:
+		return p
+	default:
+		return priorityNormal
+	}
+}
+
+// crawlIntervalMultiplier scales schedulerInterval per priority: critical
+// keywords are crawled more often than the default interval, low-priority
+// keywords less often, so a fixed number of scheduler ticks buys critical
+// keywords more freshness at low-priority keywords' expense.
+func crawlIntervalMultiplier(p keywordPriority) float64 {
+	switch normalizePriority(p) {
+	case priorityCritical:
+		return 0.25
+	case priorityLow:
+		return 4
+	default:
+		return 1
+	}
+}
+
+// apiSourceNamesForPriority returns which of fetchAllFeeds's per-source
+// goroutines to run for a keyword at priority p: normal gets the
+// long-standing default set, low trims it to the cheapest/fastest source,
+// and critical adds every registered scraping-category source on top of
+// the default so nothing available is left uncrawled for a keyword that
+// matters most. TikTok is further gated behind the "tiktok_source" feature
+// flag even at critical priority, since it's still an experimental source.
+func apiSourceNamesForPriority(p keywordPriority, keyword string) []string {
+	switch normalizePriority(p) {
+	case priorityLow:
+		return []string{"Twitter"}
+	case priorityCritical:
+		names := []string{"Twitter", "YouTube", "Instagram", "Facebook"}
+		for sourceName, category := range sourceCategories {
+			if category == categoryScraper {
+				if sourceName == "TikTok" && !isFeatureEnabled("tiktok_source", keyword) {
+					continue
+				}
+				names = append(names, sourceName)
+			}
+		}
+		return names
+	default:
+		return []string{"Twitter", "YouTube", "Instagram", "Facebook"}
+	}
+}
+
+// remainingQuotaFraction estimates how much of today's metered-API budget
+// is left. This codebase tracks estimated spend rather than raw
+// per-provider quota units (costs.go), so spend headroom against
+// dailyCostCapUSD is used as the "remaining quota" signal.
+func remainingQuotaFraction() float64 {
+	if dailyCostCapUSD <= 0 {
+		return 1
+	}
+	remaining := 1 - dailySpendUSD()/dailyCostCapUSD
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// quotaAllowsCrawl reports whether a keyword at priority p should still be
+// crawled given the fraction of today's quota remaining. Critical keywords
+// crawl until the budget is fully exhausted; normal keywords stop once
+// quota is running low; low-priority keywords are cut first, well before
+// the budget actually runs out.
+func quotaAllowsCrawl(p keywordPriority, remaining float64) bool {
+	switch normalizePriority(p) {
+	case priorityCritical:
+		return remaining > 0
+	case priorityLow:
+		return remaining > 0.5
+	default:
+		return remaining > 0.15
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// resultProvenance records how a FeedResult entered the system: which
+// source and query produced it, which crawl job (if any) it came from,
+// and which enrichments and transformations have since been applied to
+// it. It's attached to FeedResult itself rather than stored separately,
+// so it travels with the result through caching, history, and exports
+// without needing its own lookup table.
+type resultProvenance struct {
+	Source          string    `json:"source,omitempty"`
+	Query           string    `json:"query,omitempty"`
+	CrawlJobID      string    `json:"crawlJobId,omitempty"`
+	Enrichments     []string  `json:"enrichments,omitempty"`
+	Transformations []string  `json:"transformations,omitempty"`
+	FetchedAt       time.Time `json:"fetchedAt,omitempty"`
+}
+
+// stampFetchProvenance records the source and query that produced results,
+// called right after a FeedSource.Fetch (or the equivalent direct fetch
+// function) succeeds.
+func stampFetchProvenance(results []FeedResult, source, query string) []FeedResult {
+	now := time.Now()
+	for i := range results {
+		results[i].Provenance.Source = source
+		results[i].Provenance.Query = query
+		results[i].Provenance.FetchedAt = now
+	}
+	return results
+}
+
+// stampCrawlJobProvenance records which crawl job produced results, called
+// once a crawlJobManager worker finishes running one.
+func stampCrawlJobProvenance(results []FeedResult, jobID string) []FeedResult {
+	for i := range results {
+		results[i].Provenance.CrawlJobID = jobID
+	}
+	return results
+}
+
+// recordEnrichment appends stageName to result's enrichment trail if it
+// isn't already there, called from enrichBatch after stage.Enrich runs.
+func recordEnrichment(result FeedResult, stageName string) FeedResult {
+	for _, name := range result.Provenance.Enrichments {
+		if name == stageName {
+			return result
+		}
+	}
+	result.Provenance.Enrichments = append(result.Provenance.Enrichments, stageName)
+	return result
+}
+
+// recordTransformation appends transformationName to result's
+// transformation trail if it isn't already there, called when a stage
+// like translation actually changes the result rather than just
+// inspecting it.
+func recordTransformation(result FeedResult, transformationName string) FeedResult {
+	for _, name := range result.Provenance.Transformations {
+		if name == transformationName {
+			return result
+		}
+	}
+	result.Provenance.Transformations = append(result.Provenance.Transformations, transformationName)
+	return result
+}
+
+// resultProvenanceHandler serves GET /results/provenance?id=&keyword=,
+// looking the result up in globalSearchIndex (searchindex.go), which
+// already keeps every indexed result addressable by ID.
+func resultProvenanceHandler(c *gin.Context) {
+	id := c.Query("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "id is required"})
+		return
+	}
+
+	globalSearchIndex.mu.RLock()
+	result, ok := globalSearchIndex.documents[id]
+	globalSearchIndex.mu.RUnlock()
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no indexed result with that id"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"id": id, "provenance": result.Provenance})
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+)
+
+// publicReadOnlyMode restricts the deployment to browsing pre-crawled
+// watched keywords only: no new searches, no upstream API calls, so a
+// public instance can't be used to burn the operator's API quotas.
+var publicReadOnlyMode = os.Getenv("PUBLIC_READONLY") == "1"
+
+// isKnownKeyword reports whether keyword has already been searched (and is
+// therefore safe to serve from cache in public read-only mode).
+func isKnownKeyword(keyword string) bool {
+	searchedKeywordsLock.Lock()
+	defer searchedKeywordsLock.Unlock()
+	_, ok := searchedKeywords[keyword]
+	return ok
+}
+
+// publicCacheHeaders sets long-lived CDN cache headers on read-only public
+// endpoints, since results only change when the background crawler
+// refreshes the cache, not per request.
+func publicCacheHeaders() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if publicReadOnlyMode {
+			c.Header("Cache-Control", "public, max-age=300")
+		}
+		c.Next()
+	}
+}
+
+// rejectSearchInPublicMode blocks new searches in public read-only mode,
+// only allowing keywords that are already known.
+func rejectSearchInPublicMode(c *gin.Context, keyword string) bool {
+	if publicReadOnlyMode && !isKnownKeyword(keyword) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "this deployment is read-only; only previously watched keywords can be browsed"})
+		return true
+	}
+	return false
+}
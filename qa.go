@@ -0,0 +1,275 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxQAContextResults bounds how many stored results get sent to the LLM
+// as context for one question, so a keyword with months of history doesn't
+// blow the model's context window or the per-call token budget.
+const maxQAContextResults = 30
+
+// qaAnswer is the response for POST /keywords/:keyword/ask.
+type qaAnswer struct {
+	Keyword   string       `json:"keyword"`
+	Question  string       `json:"question"`
+	Answer    string       `json:"answer"`
+	Citations []qaCitation `json:"citations"`
+	Results   int          `json:"resultsConsidered"`
+}
+
+// qaCitation resolves one of the answer's inline [id] references back to
+// the stored result it points at, so a client can render a real link
+// instead of a bare ID.
+type qaCitation struct {
+	ResultID string `json:"resultId"`
+	Title    string `json:"title"`
+	Link     string `json:"link"`
+}
+
+// citationPattern matches the "[<resultID>]" markers the system prompt
+// instructs the model to cite with.
+var citationPattern = regexp.MustCompile(`\[([A-Za-z0-9]+)\]`)
+
+// questionAnswerHandler serves POST /keywords/:keyword/ask: given a
+// question, it retrieves that keyword's stored results, asks the
+// configured LLM backend to answer using only those results, and resolves
+// the model's inline [id] citations back to the stored results they
+// reference. It's gated behind the llm_summarization feature flag and a
+// configured LLM_API_KEY, since neither is available in every deployment
+// of this codebase.
+func questionAnswerHandler(c *gin.Context) {
+	keyword := c.Param("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is required"})
+		return
+	}
+	if !isFeatureEnabled("llm_summarization", keyword) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "llm_summarization feature flag is disabled"})
+		return
+	}
+	if appConfig == nil || appConfig.LLMAPIKey == "" {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "LLM_API_KEY is not configured"})
+		return
+	}
+
+	var body struct {
+		Question string `json:"question"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil || body.Question == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "question is required"})
+		return
+	}
+
+	appDBLock.Lock()
+	entries := appDB.History[keyword]
+	appDBLock.Unlock()
+	if len(entries) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no stored results for this keyword"})
+		return
+	}
+
+	var all []FeedResult
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		for _, result := range entry.Results {
+			if result.ID == "" || seen[result.ID] {
+				continue
+			}
+			seen[result.ID] = true
+			all = append(all, result)
+		}
+	}
+
+	relevant := selectRelevantResults(body.Question, all, maxQAContextResults)
+	if len(relevant) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no stored results are relevant to this question"})
+		return
+	}
+
+	answer, err := askLLM(c.Request.Context(), body.Question, relevant)
+	if err != nil {
+		err = classifySourceError(err)
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+	recordCost("llm_token", keyword, float64(len(body.Question)+len(answer)))
+
+	byID := make(map[string]FeedResult, len(relevant))
+	for _, result := range relevant {
+		byID[result.ID] = result
+	}
+	var citations []qaCitation
+	citedIDs := make(map[string]bool)
+	for _, match := range citationPattern.FindAllStringSubmatch(answer, -1) {
+		id := match[1]
+		if citedIDs[id] {
+			continue
+		}
+		if result, ok := byID[id]; ok {
+			citedIDs[id] = true
+			citations = append(citations, qaCitation{ResultID: result.ID, Title: result.Title, Link: result.Link})
+		}
+	}
+
+	c.JSON(http.StatusOK, qaAnswer{
+		Keyword:   keyword,
+		Question:  body.Question,
+		Answer:    answer,
+		Citations: citations,
+		Results:   len(relevant),
+	})
+}
+
+// selectRelevantResults ranks results by how many of the question's
+// significant words appear in their title/description, breaking ties by
+// recency, and returns the top limit. This is a naive bag-of-words scorer
+// rather than real embeddings-based retrieval, since this codebase has no
+// vector search dependency; it's enough to keep the LLM's context focused
+// on results that actually mention what was asked about.
+func selectRelevantResults(question string, results []FeedResult, limit int) []FeedResult {
+	terms := significantWords(question)
+
+	type scored struct {
+		result FeedResult
+		score  int
+	}
+	candidates := make([]scored, 0, len(results))
+	for _, result := range results {
+		words := significantWords(result.Title + " " + result.Description)
+		wordSet := make(map[string]bool, len(words))
+		for _, w := range words {
+			wordSet[w] = true
+		}
+		score := 0
+		for _, term := range terms {
+			if wordSet[term] {
+				score++
+			}
+		}
+		if score > 0 {
+			candidates = append(candidates, scored{result: result, score: score})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].result.PublishedTime.After(candidates[j].result.PublishedTime)
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	out := make([]FeedResult, len(candidates))
+	for i, c := range candidates {
+		out[i] = c.result
+	}
+	return out
+}
+
+// significantWords lowercases text and strips it down to words longer than
+// three characters, filtering out the stopwords too common to be useful
+// signal for relevance scoring.
+func significantWords(text string) []string {
+	var stopwords = map[string]bool{
+		"this": true, "that": true, "with": true, "from": true, "have": true,
+		"what": true, "were": true, "been": true, "about": true, "which": true,
+		"their": true, "there": true,
+	}
+
+	var words []string
+	for _, w := range strings.Fields(strings.ToLower(text)) {
+		w = strings.Trim(w, ".,!?\"'()")
+		if len(w) > 3 && !stopwords[w] {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// llmChatRequest/llmChatResponse are the (OpenAI-compatible) chat
+// completions request/response shapes; appConfig.LLMAPIBaseURL is expected
+// to speak this dialect, which is the de facto standard most hosted and
+// self-hosted LLM backends implement.
+type llmChatRequest struct {
+	Model    string           `json:"model"`
+	Messages []llmChatMessage `json:"messages"`
+}
+
+type llmChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type llmChatResponse struct {
+	Choices []struct {
+		Message llmChatMessage `json:"message"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// askLLM sends question plus a numbered digest of context to the
+// configured LLM backend and returns its answer text.
+func askLLM(ctx context.Context, question string, sourceResults []FeedResult) (string, error) {
+	system := "You answer questions about news/social media results using only the results provided. " +
+		"Cite every claim with the bracketed ID of the result it came from, e.g. [abc123]. " +
+		"If the results don't contain an answer, say so instead of guessing."
+
+	var digest bytes.Buffer
+	for _, result := range sourceResults {
+		fmt.Fprintf(&digest, "[%s] %s — %s (%s, %s)\n", result.ID, result.Title, result.Description, result.Source, result.Published)
+	}
+
+	reqBody := llmChatRequest{
+		Model: appConfig.LLMModel,
+		Messages: []llmChatMessage{
+			{Role: "system", Content: system},
+			{Role: "user", Content: fmt.Sprintf("Results:\n%s\nQuestion: %s", digest.String(), question)},
+		},
+	}
+	payload, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("error encoding LLM request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, appConfig.LLMAPIBaseURL+"/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("error building LLM request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+appConfig.LLMAPIKey)
+
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error calling LLM backend: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var parsed llmChatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", fmt.Errorf("error decoding LLM response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		if parsed.Error != nil {
+			return "", fmt.Errorf("LLM backend error (%d): %s", resp.StatusCode, parsed.Error.Message)
+		}
+		return "", fmt.Errorf("LLM backend returned status %d", resp.StatusCode)
+	}
+	if len(parsed.Choices) == 0 {
+		return "", fmt.Errorf("LLM backend returned no choices")
+	}
+	return parsed.Choices[0].Message.Content, nil
+}
@@ -0,0 +1,354 @@
+package main
+
+import "strings"
+
+// QueryPlan compiles a single boolean search query (AND/OR/NOT, parenthesized
+// groups, quoted phrases and "-term" exclusions, e.g.
+// `"climate change" AND (policy OR law) -opinion`) into the native query
+// shape each backend understands, plus a local matcher for backends that
+// can't express the query natively at all.
+type QueryPlan struct {
+	Raw          string
+	NewsAPIQuery string // NewsAPI understands AND/OR/NOT and parentheses natively.
+	YouTubeQuery string // YouTube has no boolean syntax; only its native "-term" exclusion survives.
+	TwitterQuery string // Twitter's search operators are flat: implicit AND, "OR", "-term", no grouping.
+	Terms        []string
+	Report       string // Human-readable explanation of how the query was compiled.
+
+	expr queryExpr
+}
+
+// compileQueryPlan parses rawQuery into per-source native forms and a local
+// boolean matcher for sources that need one.
+func compileQueryPlan(rawQuery string) QueryPlan {
+	tokens := tokenizeQuery(rawQuery)
+	expr, _ := (&queryParser{tokens: tokens}).parseOr()
+	if expr == nil {
+		expr = wordExpr{word: rawQuery}
+	}
+
+	plan := QueryPlan{
+		Raw:          rawQuery,
+		NewsAPIQuery: renderNewsAPIQuery(expr),
+		YouTubeQuery: renderYouTubeQuery(expr),
+		TwitterQuery: renderTwitterQuery(expr),
+		Terms:        collectQueryTerms(expr),
+		expr:         expr,
+	}
+
+	if _, ok := expr.(wordExpr); ok {
+		plan.Report = "Single-term query: no translation required for any source."
+	} else {
+		plan.Report = "NewsAPI: rendered as native AND/OR/NOT with parentheses. " +
+			"YouTube: grouping dropped, only native \"-term\" exclusion kept. " +
+			"Twitter: grouping dropped, rendered as its flat AND/OR/\"-term\" syntax. " +
+			"RSS and other sources with no query language: matched locally against the full boolean expression."
+	}
+
+	return plan
+}
+
+// matchesResidualFilter reports whether text satisfies the plan's boolean
+// expression, for sources with no native (or no) query language of their
+// own to hand the query to.
+func (p QueryPlan) matchesResidualFilter(text string) bool {
+	return p.expr.matches(strings.ToLower(text))
+}
+
+// --- Parsing ---
+//
+// Grammar (OR binds loosest, AND may be implicit between adjacent factors,
+// NOT/"-" binds tightest):
+//
+//	orExpr    := andExpr ("OR" andExpr)*
+//	andExpr   := unary ([ "AND" ] unary)*
+//	unary     := ("NOT" | "-") unary | primary
+//	primary   := "(" orExpr ")" | phrase | word
+
+type queryExpr interface {
+	matches(lowerText string) bool
+}
+
+type wordExpr struct{ word string }
+
+func (w wordExpr) matches(lowerText string) bool {
+	return strings.Contains(lowerText, strings.ToLower(w.word))
+}
+
+type notExpr struct{ inner queryExpr }
+
+func (n notExpr) matches(lowerText string) bool { return !n.inner.matches(lowerText) }
+
+type andExpr struct{ children []queryExpr }
+
+func (a andExpr) matches(lowerText string) bool {
+	for _, child := range a.children {
+		if !child.matches(lowerText) {
+			return false
+		}
+	}
+	return true
+}
+
+type orExpr struct{ children []queryExpr }
+
+func (o orExpr) matches(lowerText string) bool {
+	for _, child := range o.children {
+		if child.matches(lowerText) {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenizeQuery splits rawQuery into words, quoted phrases (kept as one
+// token, quotes stripped) and lone "(" / ")" tokens. A leading "-" on a word
+// or phrase is kept attached so the parser can treat it as negation.
+func tokenizeQuery(rawQuery string) []string {
+	var tokens []string
+	runes := []rune(rawQuery)
+	for i := 0; i < len(runes); {
+		switch {
+		case runes[i] == ' ':
+			i++
+		case runes[i] == '(' || runes[i] == ')':
+			tokens = append(tokens, string(runes[i]))
+			i++
+		case runes[i] == '"' || (runes[i] == '-' && i+1 < len(runes) && runes[i+1] == '"'):
+			negated := runes[i] == '-'
+			if negated {
+				i++
+			}
+			i++ // opening quote
+			start := i
+			for i < len(runes) && runes[i] != '"' {
+				i++
+			}
+			phrase := string(runes[start:i])
+			if i < len(runes) {
+				i++ // closing quote
+			}
+			if negated {
+				phrase = "-" + phrase
+			}
+			tokens = append(tokens, phrase)
+		default:
+			start := i
+			for i < len(runes) && runes[i] != ' ' && runes[i] != '(' && runes[i] != ')' {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		}
+	}
+	return tokens
+}
+
+type queryParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *queryParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) next() string {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+func (p *queryParser) parseOr() (queryExpr, bool) {
+	first, ok := p.parseAnd()
+	if !ok {
+		return nil, false
+	}
+	children := []queryExpr{first}
+	for strings.EqualFold(p.peek(), "OR") {
+		p.next()
+		next, ok := p.parseAnd()
+		if !ok {
+			break
+		}
+		children = append(children, next)
+	}
+	if len(children) == 1 {
+		return children[0], true
+	}
+	return orExpr{children: children}, true
+}
+
+func (p *queryParser) parseAnd() (queryExpr, bool) {
+	first, ok := p.parseUnary()
+	if !ok {
+		return nil, false
+	}
+	children := []queryExpr{first}
+	for {
+		if strings.EqualFold(p.peek(), "AND") {
+			p.next()
+		} else if p.peek() == "" || p.peek() == ")" || strings.EqualFold(p.peek(), "OR") {
+			break
+		}
+		next, ok := p.parseUnary()
+		if !ok {
+			break
+		}
+		children = append(children, next)
+	}
+	if len(children) == 1 {
+		return children[0], true
+	}
+	return andExpr{children: children}, true
+}
+
+func (p *queryParser) parseUnary() (queryExpr, bool) {
+	if strings.EqualFold(p.peek(), "NOT") {
+		p.next()
+		inner, ok := p.parseUnary()
+		if !ok {
+			return nil, false
+		}
+		return notExpr{inner: inner}, true
+	}
+	if strings.HasPrefix(p.peek(), "-") && p.peek() != "-" {
+		tok := p.next()
+		return notExpr{inner: wordExpr{word: strings.TrimPrefix(tok, "-")}}, true
+	}
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (queryExpr, bool) {
+	tok := p.peek()
+	if tok == "" {
+		return nil, false
+	}
+	if tok == "(" {
+		p.next()
+		inner, ok := p.parseOr()
+		if p.peek() == ")" {
+			p.next()
+		}
+		return inner, ok
+	}
+	p.next()
+	return wordExpr{word: tok}, true
+}
+
+// --- Rendering per source ---
+
+// renderNewsAPIQuery reconstructs the boolean expression using NewsAPI's
+// native AND/OR/NOT keywords and parentheses.
+func renderNewsAPIQuery(expr queryExpr) string {
+	switch e := expr.(type) {
+	case wordExpr:
+		return quoteIfPhrase(e.word)
+	case notExpr:
+		return "NOT " + renderNewsAPIQuery(e.inner)
+	case andExpr:
+		return joinRendered(e.children, " AND ", renderNewsAPIQuery)
+	case orExpr:
+		return joinRendered(e.children, " OR ", renderNewsAPIQuery)
+	default:
+		return ""
+	}
+}
+
+// renderYouTubeQuery flattens the expression for YouTube's q parameter,
+// which has no AND/OR/parentheses support but does honor a leading "-" to
+// exclude a term. Grouping is dropped; every leaf is included, negated ones
+// keeping their "-" prefix.
+func renderYouTubeQuery(expr queryExpr) string {
+	var words []string
+	flattenYouTube(expr, false, &words)
+	return strings.Join(words, " ")
+}
+
+func flattenYouTube(expr queryExpr, negate bool, words *[]string) {
+	switch e := expr.(type) {
+	case wordExpr:
+		if negate {
+			*words = append(*words, "-"+e.word)
+		} else {
+			*words = append(*words, e.word)
+		}
+	case notExpr:
+		flattenYouTube(e.inner, !negate, words)
+	case andExpr:
+		for _, child := range e.children {
+			flattenYouTube(child, negate, words)
+		}
+	case orExpr:
+		for _, child := range e.children {
+			flattenYouTube(child, negate, words)
+		}
+	}
+}
+
+// renderTwitterQuery flattens the expression for Twitter's flat search
+// operators: adjacent terms are an implicit AND, "OR" and a leading "-" for
+// exclusion are both native, but there's no way to express grouping, so
+// parentheses are dropped.
+func renderTwitterQuery(expr queryExpr) string {
+	switch e := expr.(type) {
+	case wordExpr:
+		return quoteIfPhrase(e.word)
+	case notExpr:
+		return "-" + renderTwitterQuery(e.inner)
+	case andExpr:
+		return joinRendered(e.children, " ", renderTwitterQuery)
+	case orExpr:
+		return joinRendered(e.children, " OR ", renderTwitterQuery)
+	default:
+		return ""
+	}
+}
+
+func joinRendered(children []queryExpr, sep string, render func(queryExpr) string) string {
+	parts := make([]string, len(children))
+	for i, child := range children {
+		parts[i] = render(child)
+	}
+	return strings.Join(parts, sep)
+}
+
+func quoteIfPhrase(word string) string {
+	if strings.Contains(word, " ") {
+		return `"` + word + `"`
+	}
+	return word
+}
+
+// collectQueryTerms returns every positive (non-negated) leaf term, in
+// order, for callers that just need a flat term list (e.g. highlighting
+// matches in a result snippet).
+func collectQueryTerms(expr queryExpr) []string {
+	var terms []string
+	var walk func(queryExpr, bool)
+	walk = func(e queryExpr, negated bool) {
+		switch node := e.(type) {
+		case wordExpr:
+			if !negated {
+				terms = append(terms, node.word)
+			}
+		case notExpr:
+			walk(node.inner, !negated)
+		case andExpr:
+			for _, child := range node.children {
+				walk(child, negated)
+			}
+		case orExpr:
+			for _, child := range node.children {
+				walk(child, negated)
+			}
+		}
+	}
+	walk(expr, false)
+	if len(terms) == 0 {
+		terms = []string{}
+	}
+	return terms
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// rankingStrategy selects how applyRanking orders a result set. "recency"
+// matches this codebase's long-standing default (sort by PublishedTime);
+// the others let a caller like a newsroom (wants the newest) and a PR team
+// (wants the loudest, or the most trustworthy) view the same underlying
+// data differently without either side needing a separate endpoint.
+type rankingStrategy string
+
+const (
+	rankRecency     rankingStrategy = "recency"
+	rankEngagement  rankingStrategy = "engagement"
+	rankPopularity  rankingStrategy = "popularity"
+	rankRelevance   rankingStrategy = "relevance"
+	rankCredibility rankingStrategy = "credibility"
+	rankCustom      rankingStrategy = "custom"
+)
+
+// sourceCredibilityWeight scores a source's trustworthiness from its
+// existing collection category (feedsource.go) — an API integration is
+// weighted above an RSS feed, which is weighted above best-effort
+// scraping. This is a coarse proxy, not a fact-checking system; a source
+// not in sourceCategories (e.g. GoogleNews, which isn't a registered
+// FeedSource) gets a neutral middle weight.
+func sourceCredibilityWeight(source string) float64 {
+	switch sourceCategories[source] {
+	case categoryAPI:
+		return 1.0
+	case categoryRSS:
+		return 0.8
+	case categoryScraper:
+		return 0.5
+	default:
+		return 0.7
+	}
+}
+
+// stampRelevanceScores sets RelevanceScore on every result in results,
+// scoring the whole cross-source batch as one BM25 corpus (tfidf.go) so a
+// term's document frequency reflects the full search, not just whichever
+// source happens to be ranked first. Called once per applyRanking, before
+// any per-source sort.
+func stampRelevanceScores(results map[string][]FeedResult, keyword string) {
+	var flat []FeedResult
+	for _, sourceResults := range results {
+		flat = append(flat, sourceResults...)
+	}
+	scores := computeRelevanceScores(flat, keyword)
+
+	for source, sourceResults := range results {
+		for i := range sourceResults {
+			sourceResults[i].RelevanceScore = scores[sourceResults[i].ID]
+		}
+		results[source] = sourceResults
+	}
+}
+
+// engagementScore uses Velocity (velocity.go) — how often this story's
+// fingerprint has been sighted per hour — as the engagement proxy
+// available today, pending real per-platform like/share counts.
+func engagementScore(result FeedResult) float64 {
+	return float64(result.Velocity)
+}
+
+// parseRankingWeights parses a "recency:0.5,engagement:0.3,credibility:0.2"
+// style query value for rank=custom. Unrecognized components and
+// unparseable weights are skipped rather than rejected, since a custom
+// ranking request is inherently exploratory.
+func parseRankingWeights(raw string) map[rankingStrategy]float64 {
+	weights := make(map[rankingStrategy]float64)
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(kv[1]), 64)
+		if err != nil {
+			continue
+		}
+		weights[rankingStrategy(strings.TrimSpace(kv[0]))] = value
+	}
+	return weights
+}
+
+// customScore blends recency, engagement, relevance and credibility by the
+// caller-supplied weights. Recency is normalized against the batch's
+// newest/oldest timestamps so it's comparable in scale to the other
+// (already roughly 0-1-ish) components.
+func customScore(result FeedResult, keyword string, weights map[rankingStrategy]float64, oldest, newest float64) float64 {
+	var recencyNorm float64
+	if newest > oldest {
+		recencyNorm = (float64(result.PublishedTime.Unix()) - oldest) / (newest - oldest)
+	}
+	return weights[rankRecency]*recencyNorm +
+		weights[rankEngagement]*engagementScore(result) +
+		weights[rankRelevance]*result.RelevanceScore +
+		weights[rankCredibility]*sourceCredibilityWeight(result.Source)
+}
+
+// rankFeedResults reorders a copy of results in place according to
+// strategy, leaving the input slice untouched.
+func rankFeedResults(results []FeedResult, keyword string, strategy rankingStrategy, weights map[rankingStrategy]float64) []FeedResult {
+	ranked := append([]FeedResult(nil), results...)
+
+	switch strategy {
+	case rankEngagement:
+		sort.SliceStable(ranked, func(i, j int) bool { return engagementScore(ranked[i]) > engagementScore(ranked[j]) })
+	case rankPopularity:
+		sort.SliceStable(ranked, func(i, j int) bool {
+			return popularityScore(ranked[i].Engagement) > popularityScore(ranked[j].Engagement)
+		})
+	case rankRelevance:
+		sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].RelevanceScore > ranked[j].RelevanceScore })
+	case rankCredibility:
+		sort.SliceStable(ranked, func(i, j int) bool {
+			return sourceCredibilityWeight(ranked[i].Source) > sourceCredibilityWeight(ranked[j].Source)
+		})
+	case rankCustom:
+		var oldest, newest float64
+		for i, result := range ranked {
+			ts := float64(result.PublishedTime.Unix())
+			if i == 0 || ts < oldest {
+				oldest = ts
+			}
+			if i == 0 || ts > newest {
+				newest = ts
+			}
+		}
+		sort.SliceStable(ranked, func(i, j int) bool {
+			return customScore(ranked[i], keyword, weights, oldest, newest) > customScore(ranked[j], keyword, weights, oldest, newest)
+		})
+	default: // rankRecency, and any unrecognized strategy
+		sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].PublishedTime.After(ranked[j].PublishedTime) })
+	}
+
+	return ranked
+}
+
+// applyRanking re-sorts every source's result slice by strategy, keeping
+// the existing per-source grouping searchHandler and apiSearchHandler
+// render — only the order within each group changes.
+func applyRanking(results map[string][]FeedResult, keyword string, strategy rankingStrategy, weights map[rankingStrategy]float64) map[string][]FeedResult {
+	if strategy == rankRelevance || strategy == rankCustom {
+		stampRelevanceScores(results, keyword)
+	}
+
+	ranked := make(map[string][]FeedResult, len(results))
+	for source, sourceResults := range results {
+		ranked[source] = rankFeedResults(sourceResults, keyword, strategy, weights)
+	}
+	return ranked
+}
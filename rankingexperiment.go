@@ -0,0 +1,101 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// rankingExperimentHandler serves GET /experiments/ranking, computing two
+// ranking strategies (ranking.go) over the same result set so a ranking
+// change can be evaluated against real traffic before it becomes the
+// default. mode=side-by-side (the default) returns both full orders;
+// mode=interleave merges them into one list per source, alternating
+// between strategies and attributing each entry to whichever strategy
+// placed it there first.
+func rankingExperimentHandler(c *gin.Context) {
+	keyword := c.Query("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is required"})
+		return
+	}
+	if rejectSearchInPublicMode(c, keyword) {
+		return
+	}
+
+	strategyA := rankingStrategy(c.DefaultQuery("strategyA", string(rankRecency)))
+	strategyB := rankingStrategy(c.DefaultQuery("strategyB", string(rankEngagement)))
+	weightsA := parseRankingWeights(c.Query("weightsA"))
+	weightsB := parseRankingWeights(c.Query("weightsB"))
+	mode := c.DefaultQuery("mode", "side-by-side")
+
+	guardrail := checkQueryGuardrails(keyword, c.Query("broadMode") == "1")
+	if guardrail.Blocked {
+		c.JSON(http.StatusBadRequest, gin.H{"error": guardrail.Warning})
+		return
+	}
+
+	ctx := withSourceGroup(c.Request.Context(), c.Query("sources"))
+	results := applyRoleRestrictions(fetchAllFeeds(ctx, keyword), isRestrictedViewer(c))
+
+	rankedA := applyRanking(results, keyword, strategyA, weightsA)
+	rankedB := applyRanking(results, keyword, strategyB, weightsB)
+
+	if mode == "interleave" {
+		c.JSON(http.StatusOK, gin.H{
+			"keyword":   keyword,
+			"strategyA": strategyA,
+			"strategyB": strategyB,
+			"results":   interleaveRankings(rankedA, rankedB),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"keyword":   keyword,
+		"strategyA": strategyA,
+		"resultsA":  rankedA,
+		"strategyB": strategyB,
+		"resultsB":  rankedB,
+	})
+}
+
+// rankedAttributedResult pairs a FeedResult with which experiment arm
+// contributed it at that position, for interleaved ranking comparisons.
+type rankedAttributedResult struct {
+	FeedResult
+	RankedBy string `json:"rankedBy"`
+}
+
+// interleaveRankings alternates between two already-ranked result sets per
+// source, skipping an item already added by the other arm (matched by ID)
+// so the same story doesn't appear twice just because both strategies
+// ranked it highly.
+func interleaveRankings(a, b map[string][]FeedResult) map[string][]rankedAttributedResult {
+	merged := make(map[string][]rankedAttributedResult)
+	sources := make(map[string]bool)
+	for source := range a {
+		sources[source] = true
+	}
+	for source := range b {
+		sources[source] = true
+	}
+
+	for source := range sources {
+		listA, listB := a[source], b[source]
+		seen := make(map[string]bool)
+		var out []rankedAttributedResult
+		for i := 0; i < len(listA) || i < len(listB); i++ {
+			if i < len(listA) && !seen[listA[i].ID] {
+				out = append(out, rankedAttributedResult{FeedResult: listA[i], RankedBy: "A"})
+				seen[listA[i].ID] = true
+			}
+			if i < len(listB) && !seen[listB[i].ID] {
+				out = append(out, rankedAttributedResult{FeedResult: listB[i], RankedBy: "B"})
+				seen[listB[i].ID] = true
+			}
+		}
+		merged[source] = out
+	}
+	return merged
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+)
+
+// redactionConfigFile is where operators declare which parts of a
+// FeedResult must not be stored or exported, for deployments that can't
+// retain personal data scraped from social posts. It's a JSON file rather
+// than an env var, matching feature_flags.json: this is deployment policy
+// an operator edits and reloads, not a single tunable.
+const redactionConfigFile = "redaction_config.json"
+
+// RedactionConfig lists the anonymization rules recordSearchHistory,
+// archiveResults and feedOutputHandler apply before a result is written to
+// disk or served to an external feed reader. All rules default to off, so
+// existing deployments see no behavior change until they opt in.
+type RedactionConfig struct {
+	StripAuthorHandles bool `json:"stripAuthorHandles"`
+	HashLinks          bool `json:"hashLinks"`
+	DropFullText       bool `json:"dropFullText"`
+}
+
+var redactionConfig RedactionConfig
+
+func loadRedactionConfig() {
+	file, err := os.Open(redactionConfigFile)
+	if err != nil {
+		slog.Info(fmt.Sprintf("No redaction config found, storing results unredacted: %s", err))
+		return
+	}
+	defer file.Close()
+
+	var cfg RedactionConfig
+	if err := json.NewDecoder(file).Decode(&cfg); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding redaction config, storing results unredacted: %s", err))
+		return
+	}
+	redactionConfig = cfg
+}
+
+// authorHandlePattern matches an @handle the way it shows up in scraped
+// Twitter text (e.g. "@jdoe: just posted...") — this predates FeedResult's
+// structured Author field (resultAuthor, author.go) and still catches
+// handles mentioned inline in a title or description.
+var authorHandlePattern = regexp.MustCompile(`@\w+`)
+
+// redactResult applies redactionConfig's active rules to one result,
+// returning a copy so callers still holding the unredacted slice (e.g. the
+// live search response) aren't affected.
+func redactResult(result FeedResult) FeedResult {
+	if redactionConfig.StripAuthorHandles {
+		result.Title = authorHandlePattern.ReplaceAllString(result.Title, "@[redacted]")
+		result.Description = authorHandlePattern.ReplaceAllString(result.Description, "@[redacted]")
+		result.Author = resultAuthor{}
+	}
+	if redactionConfig.HashLinks {
+		result.Link = hashRedactedLink(result.Link)
+	}
+	if redactionConfig.DropFullText {
+		result.Description = ""
+	}
+	return result
+}
+
+// redactResults applies redactResult to every item in results.
+func redactResults(results []FeedResult) []FeedResult {
+	redacted := make([]FeedResult, len(results))
+	for i, result := range results {
+		redacted[i] = redactResult(result)
+	}
+	return redacted
+}
+
+func hashRedactedLink(link string) string {
+	sum := sha256.Sum256([]byte(link))
+	return "redacted:" + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// redditWatchlistFile lists subreddits to poll for new posts (and, for
+// posts that match, their top comments), independent of any keyword
+// search. It's a JSON file rather than an appDB.Watchlist entry because a
+// subreddit watch isn't scoped to one search keyword — it's a standing feed
+// an operator wants monitored regardless of what anyone searches for.
+const redditWatchlistFile = "reddit_watchlist.json"
+
+// redditWatchInterval mirrors schedulerDefaultInterval's role for keyword
+// crawls, overridable via REDDIT_WATCH_INTERVAL_SECONDS.
+const redditWatchDefaultInterval = 10 * time.Minute
+
+var redditWatchInterval = redditWatchDefaultInterval
+
+// RedditWatchEntry is one subreddit an operator wants monitored.
+// Keywords is optional: an empty list means "every new post matches."
+type RedditWatchEntry struct {
+	Subreddit string   `json:"subreddit"`
+	Keywords  []string `json:"keywords,omitempty"`
+}
+
+func loadRedditWatchInterval() {
+	if raw := os.Getenv("REDDIT_WATCH_INTERVAL_SECONDS"); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			redditWatchInterval = time.Duration(n) * time.Second
+		}
+	}
+}
+
+// loadRedditWatchlist reads redditWatchlistFile. A missing file is not an
+// error: it just means nothing is watched yet, matching how
+// collection_policy.json and feature_flags.json treat their own absence.
+func loadRedditWatchlist() ([]RedditWatchEntry, error) {
+	file, err := os.Open(redditWatchlistFile)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening reddit watchlist: %w", err)
+	}
+	defer file.Close()
+
+	var data struct {
+		Subreddits []RedditWatchEntry `json:"subreddits"`
+	}
+	if err := json.NewDecoder(file).Decode(&data); err != nil {
+		return nil, fmt.Errorf("error decoding reddit watchlist: %w", err)
+	}
+	return data.Subreddits, nil
+}
+
+func saveRedditWatchlist(entries []RedditWatchEntry) error {
+	file, err := os.Create(redditWatchlistFile)
+	if err != nil {
+		return fmt.Errorf("error creating reddit watchlist: %w", err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "    ")
+	return encoder.Encode(struct {
+		Subreddits []RedditWatchEntry `json:"subreddits"`
+	}{Subreddits: entries})
+}
+
+// startRedditWatchJob starts the background poll loop, the same
+// ticker-plus-per-item-recover shape as startScheduledCrawler.
+func startRedditWatchJob() {
+	go func() {
+		ticker := time.NewTicker(redditWatchInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			runRedditWatchPoll()
+		}
+	}()
+}
+
+func runRedditWatchPoll() {
+	entries, err := loadRedditWatchlist()
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error loading reddit watchlist: %s", err))
+		return
+	}
+	for _, entry := range entries {
+		pollSubreddit(entry)
+	}
+}
+
+func pollSubreddit(entry RedditWatchEntry) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error(fmt.Sprintf("Recovered from panic polling subreddit %q: %v", entry.Subreddit, r))
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), schedulerCrawlTimeout)
+	defer cancel()
+
+	results, err := fetchSubredditNewPosts(ctx, entry)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error polling subreddit %q: %s", entry.Subreddit, err))
+		return
+	}
+	results = filterUnsafeResults(results)
+
+	watchKey := "reddit:" + entry.Subreddit
+	recordSearchHistory(watchKey, map[string][]FeedResult{"Reddit": results})
+	for _, result := range results {
+		notifyIfNew(watchKey, result)
+	}
+}
+
+// redditListing mirrors the shape Reddit's public JSON API (both
+// /r/x/new.json and /comments/x.json) returns: a "Listing" wrapping
+// "children," each a "t1" (comment) or "t3" (post) with the fields this
+// aggregator cares about, ignoring the rest.
+type redditListing struct {
+	Data struct {
+		Children []struct {
+			Data struct {
+				ID          string  `json:"id"`
+				Title       string  `json:"title"`
+				Selftext    string  `json:"selftext"`
+				Body        string  `json:"body"`
+				Author      string  `json:"author"`
+				Permalink   string  `json:"permalink"`
+				CreatedUTC  float64 `json:"created_utc"`
+				Thumbnail   string  `json:"thumbnail"`
+				Score       int     `json:"score"`
+				NumComments int     `json:"num_comments"`
+				Over18      bool    `json:"over_18"`
+			} `json:"data"`
+		} `json:"children"`
+	} `json:"data"`
+}
+
+// redditKeywordMatches reports whether text contains any of keywords
+// (case-insensitively), or matches everything when keywords is empty.
+func redditKeywordMatches(keywords []string, text string) bool {
+	if len(keywords) == 0 {
+		return true
+	}
+	lower := strings.ToLower(text)
+	for _, keyword := range keywords {
+		if strings.Contains(lower, strings.ToLower(keyword)) {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchSubredditNewPosts fetches entry.Subreddit's newest posts, keeping
+// those matching entry.Keywords, then fetches top comments for each
+// matching post and keeps comments that independently match too — "new
+// posts + top comments" from the request, both filtered locally the same
+// way RSS filters its own feed content.
+func fetchSubredditNewPosts(ctx context.Context, entry RedditWatchEntry) ([]FeedResult, error) {
+	var listing redditListing
+	newPostsURL := fmt.Sprintf("https://www.reddit.com/r/%s/new.json?limit=25", strings.TrimPrefix(entry.Subreddit, "r/"))
+	if err := getRedditJSON(ctx, newPostsURL, &listing); err != nil {
+		return nil, fmt.Errorf("error fetching new posts for r/%s: %w", entry.Subreddit, err)
+	}
+
+	var results []FeedResult
+	for _, child := range listing.Data.Children {
+		post := child.Data
+		matchText := post.Title + " " + post.Selftext
+		if !redditKeywordMatches(entry.Keywords, matchText) {
+			continue
+		}
+
+		published := time.Unix(int64(post.CreatedUTC), 0)
+		results = append(results, FeedResult{
+			ID:            post.ID,
+			Title:         post.Title,
+			Link:          "https://www.reddit.com" + post.Permalink,
+			Published:     published.Format("2006-01-02 15:04:05"),
+			PublishedTime: published,
+			Description:   post.Selftext,
+			Source:        "Reddit",
+			Thumbnail:     post.Thumbnail,
+			Author:        resultAuthor{Name: post.Author, Handle: "u/" + post.Author, ProfileURL: "https://www.reddit.com/user/" + post.Author},
+			Engagement:    engagementMetrics{Likes: post.Score, Comments: post.NumComments},
+			NSFW:          post.Over18,
+		})
+
+		comments, err := fetchTopComments(ctx, entry, post.Permalink, post.Title, post.Over18)
+		if err != nil {
+			slog.Error(fmt.Sprintf("Error fetching comments for %s: %s", post.Permalink, err))
+			continue
+		}
+		results = append(results, comments...)
+	}
+	return results, nil
+}
+
+// fetchTopComments fetches up to 5 top-level comments for one post and
+// keeps those matching entry.Keywords independently of whether the post
+// itself matched. Reddit's comment objects don't carry their own over_18
+// field, so over18 is the parent post's flag, passed down by the caller.
+func fetchTopComments(ctx context.Context, entry RedditWatchEntry, permalink, postTitle string, over18 bool) ([]FeedResult, error) {
+	var thread []redditListing
+	commentsURL := fmt.Sprintf("https://www.reddit.com%scomments.json?sort=top&limit=5", strings.TrimSuffix(permalink, "/")+"/")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, commentsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "SocialMediaAggregator/1.0 (reddit subreddit watch)")
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("reddit comments request failed with status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&thread); err != nil {
+		return nil, err
+	}
+	if len(thread) < 2 {
+		return nil, nil
+	}
+
+	var results []FeedResult
+	for _, child := range thread[1].Data.Children {
+		comment := child.Data
+		if !redditKeywordMatches(entry.Keywords, comment.Body) {
+			continue
+		}
+		published := time.Unix(int64(comment.CreatedUTC), 0)
+		results = append(results, FeedResult{
+			ID:            comment.ID,
+			Title:         fmt.Sprintf("Comment on %q", postTitle),
+			Link:          "https://www.reddit.com" + permalink,
+			Published:     published.Format("2006-01-02 15:04:05"),
+			PublishedTime: published,
+			Description:   comment.Body,
+			Source:        "Reddit",
+			Author:        resultAuthor{Name: comment.Author, Handle: "u/" + comment.Author, ProfileURL: "https://www.reddit.com/user/" + comment.Author},
+			Engagement:    engagementMetrics{Likes: comment.Score},
+			NSFW:          over18,
+		})
+	}
+	return results, nil
+}
+
+func getRedditJSON(ctx context.Context, requestURL string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	// Reddit's public JSON API rejects Go's default User-Agent, so every
+	// request needs an explicit, descriptive one.
+	req.Header.Set("User-Agent", "SocialMediaAggregator/1.0 (subreddit watch)")
+
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("reddit request failed with status %d", resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// redditWatchlistHandler serves GET /admin/reddit-watchlist and
+// POST /admin/reddit-watchlist, letting the admin dashboard manage
+// subreddit watches the same way it manages news sources.
+func redditWatchlistHandler(c *gin.Context) {
+	entries, err := loadRedditWatchlist()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"subreddits": entries})
+}
+
+func addRedditWatchHandler(c *gin.Context) {
+	if !requireUnrestricted(c) {
+		return
+	}
+
+	var entry RedditWatchEntry
+	if err := c.ShouldBindJSON(&entry); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if entry.Subreddit == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "subreddit is required"})
+		return
+	}
+
+	entries, err := loadRedditWatchlist()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	for _, existing := range entries {
+		if existing.Subreddit == entry.Subreddit {
+			c.JSON(http.StatusConflict, gin.H{"error": "subreddit already watched"})
+			return
+		}
+	}
+	entries = append(entries, entry)
+
+	if err := saveRedditWatchlist(entries); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"subreddit": entry})
+}
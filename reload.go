@@ -0,0 +1,107 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/gin-gonic/gin"
+)
+
+// reloadableConfig holds the subset of configuration that can be swapped in
+// at runtime without a restart: news sources and Twitter handles. Fetchers
+// read through the accessors below rather than the package-level variables
+// directly, so a reload is visible to in-flight and future fetches alike.
+var (
+	reloadableMu sync.RWMutex
+)
+
+func currentTwitterHandles() []string {
+	reloadableMu.RLock()
+	defer reloadableMu.RUnlock()
+	return twitterHandles
+}
+
+func currentTwitterLists() []string {
+	reloadableMu.RLock()
+	defer reloadableMu.RUnlock()
+	return twitterLists
+}
+
+// reloadConfig re-reads news_sources.json and twitterhandles.json, validates
+// them, and only swaps the live configuration in if both loaded cleanly —
+// an invalid or missing file leaves the previous configuration in place.
+func reloadConfig() error {
+	newSources, err := loadNewsSources("news_sources.json")
+	if err != nil {
+		return fmt.Errorf("reload aborted, news sources invalid: %w", err)
+	}
+	if len(newSources) == 0 {
+		return fmt.Errorf("reload aborted, news sources file is empty")
+	}
+
+	newHandles := loadTwitterHandlesSafe("twitterhandles.json")
+	if len(newHandles) == 0 {
+		return fmt.Errorf("reload aborted, twitter handles file is empty")
+	}
+	newLists := loadTwitterLists()
+
+	reloadableMu.Lock()
+	twitterHandles = newHandles
+	twitterLists = newLists
+	reloadableMu.Unlock()
+
+	slog.Info(fmt.Sprintf("Configuration reloaded: %d sources, %d twitter handles, %d twitter lists", len(newSources), len(newHandles), len(newLists)))
+	return nil
+}
+
+// loadTwitterHandlesSafe behaves like loadTwitterHandles but returns an
+// error instead of exiting the process, so a bad reload doesn't take down a
+// running server.
+func loadTwitterHandlesSafe(filename string) []string {
+	file, err := os.Open(filename)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error opening %s during reload: %s", filename, err))
+		return nil
+	}
+	defer file.Close()
+
+	var data struct {
+		Handles []string `json:"handles"`
+	}
+	if err := json.NewDecoder(file).Decode(&data); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding %s during reload: %s", filename, err))
+		return nil
+	}
+	return data.Handles
+}
+
+// watchForReloadSignal reloads configuration whenever the process receives
+// SIGHUP, avoiding a restart that would drop in-flight crawls.
+func watchForReloadSignal() {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	go func() {
+		for range sigCh {
+			slog.Info(fmt.Sprintln("Received SIGHUP, reloading configuration"))
+			if err := reloadConfig(); err != nil {
+				slog.Error(fmt.Sprintf("Config reload failed: %s", err))
+			}
+		}
+	}()
+}
+
+// adminReloadHandler is the HTTP equivalent of sending SIGHUP, for
+// deployments where signaling the process isn't convenient.
+func adminReloadHandler(c *gin.Context) {
+	if err := reloadConfig(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "reloaded"})
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requestIDKey is the context key requestIDMiddleware stores the request ID
+// under, so slog calls deep in a fetch (goroutines included, as long as
+// they're handed c.Request.Context()) can be tied back to the request that
+// triggered them.
+type requestIDContextKey struct{}
+
+// requestIDHeader is the header a request ID is read from (if the caller
+// already has one, e.g. from an upstream load balancer) or echoed back on.
+const requestIDHeader = "X-Request-ID"
+
+// requestIDMiddleware attaches a request ID to every request: it reuses one
+// supplied via X-Request-ID, or generates one otherwise, then stores it on
+// the request's context.Context and echoes it in the response header so
+// callers and logs can correlate a single request end to end.
+func requestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestIDHeader)
+		if id == "" {
+			id = newRequestID()
+		}
+
+		ctx := context.WithValue(c.Request.Context(), requestIDContextKey{}, id)
+		c.Request = c.Request.WithContext(ctx)
+		c.Header(requestIDHeader, id)
+		c.Next()
+	}
+}
+
+// requestIDFromContext returns the request ID stored by requestIDMiddleware,
+// or "" if ctx wasn't derived from a request that passed through it.
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey{}).(string)
+	return id
+}
+
+// newRequestID returns a random 16-character hex request ID.
+func newRequestID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// computeResultID derives a deterministic ID for a result from its source,
+// canonical link and published time, so the same article crawled twice
+// (or reached through two sources with different tracking params on the
+// URL) gets the same ID everywhere: the API, the embedded store and
+// notifications.
+func computeResultID(source string, link string, publishedTime string) string {
+	hash := sha256.Sum256([]byte(source + "|" + canonicalizeURL(link) + "|" + publishedTime))
+	return hex.EncodeToString(hash[:])[:16]
+}
+
+// annotateStableIDs sets ID on every result that doesn't already have one.
+func annotateStableIDs(source string, results []FeedResult) []FeedResult {
+	for i := range results {
+		if results[i].ID == "" {
+			results[i].ID = computeResultID(source, results[i].Link, results[i].Published)
+		}
+	}
+	return results
+}
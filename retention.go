@@ -0,0 +1,171 @@
+package main
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RetentionConfig controls how long results stay in the hot in-memory cache
+// before being compressed and moved to the archive tier, and how long
+// archived results are kept before being deleted for good.
+type RetentionConfig struct {
+	HotDays     int    `json:"hotDays"`
+	ArchiveDays int    `json:"archiveDays"`
+	ArchiveDir  string `json:"archiveDir"`
+}
+
+var retentionConfig = RetentionConfig{
+	HotDays:     7,
+	ArchiveDays: 90,
+	ArchiveDir:  "archive",
+}
+
+var (
+	cacheStoredAt     = make(map[string]time.Time)
+	cacheStoredAtLock sync.Mutex
+)
+
+// rememberCacheEntryTime records when a hot cache entry was written so the
+// compaction job knows when it becomes eligible for archival.
+func rememberCacheEntryTime(key string) {
+	cacheStoredAtLock.Lock()
+	cacheStoredAt[key] = time.Now()
+	cacheStoredAtLock.Unlock()
+}
+
+// startRetentionJob runs the background compaction loop that moves stale hot
+// results into compressed archive files, and deletes archives older than
+// ArchiveDays. It is safe to call once at startup.
+func startRetentionJob() {
+	go func() {
+		ticker := time.NewTicker(1 * time.Hour)
+		defer ticker.Stop()
+		for range ticker.C {
+			runRetentionCompaction()
+		}
+	}()
+}
+
+func runRetentionCompaction() {
+	hotCutoff := time.Now().AddDate(0, 0, -retentionConfig.HotDays)
+
+	cacheStoredAtLock.Lock()
+	stale := make([]string, 0)
+	for key, storedAt := range cacheStoredAt {
+		if storedAt.Before(hotCutoff) {
+			stale = append(stale, key)
+		}
+	}
+	cacheStoredAtLock.Unlock()
+
+	for _, key := range stale {
+		value, ok := cache.Load(key)
+		if !ok {
+			continue
+		}
+		results, ok := value.([]FeedResult)
+		if !ok {
+			continue
+		}
+		if err := archiveResults(key, results); err != nil {
+			slog.Error(fmt.Sprintf("Error archiving cache entry %s: %s", key, err))
+			continue
+		}
+		cache.Delete(key)
+		cacheStoredAtLock.Lock()
+		delete(cacheStoredAt, key)
+		cacheStoredAtLock.Unlock()
+	}
+
+	archiveCutoff := time.Now().AddDate(0, 0, -retentionConfig.ArchiveDays)
+	purgeExpiredArchives(archiveCutoff)
+}
+
+func archiveFilePath(key string) string {
+	safeName := filepath.Base(key) + ".json.gz"
+	return filepath.Join(retentionConfig.ArchiveDir, safeName)
+}
+
+func archiveResults(key string, results []FeedResult) error {
+	results = redactResults(results)
+
+	if err := os.MkdirAll(retentionConfig.ArchiveDir, 0755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(archiveFilePath(key))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gz := gzip.NewWriter(file)
+	defer gz.Close()
+
+	return json.NewEncoder(gz).Encode(results)
+}
+
+func loadArchivedResults(key string) ([]FeedResult, error) {
+	file, err := os.Open(archiveFilePath(key))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	var results []FeedResult
+	if err := json.NewDecoder(gz).Decode(&results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func purgeExpiredArchives(cutoff time.Time) {
+	entries, err := os.ReadDir(retentionConfig.ArchiveDir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if info.ModTime().Before(cutoff) {
+			path := filepath.Join(retentionConfig.ArchiveDir, entry.Name())
+			if err := os.Remove(path); err != nil {
+				slog.Error(fmt.Sprintf("Error purging expired archive %s: %s", path, err))
+			}
+		}
+	}
+}
+
+// archiveQueryHandler transparently merges hot cache and archived results for
+// a keyword so callers don't need to know which tier the data lives in.
+func archiveQueryHandler(c *gin.Context) {
+	keyword := c.Param("keyword")
+	key := "news:" + keyword
+
+	var combined []FeedResult
+	if hot, ok := cache.Load(key); ok {
+		combined = append(combined, hot.([]FeedResult)...)
+	}
+	if archived, err := loadArchivedResults(key); err == nil {
+		combined = append(combined, archived...)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"keyword": keyword, "results": combined})
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// retryMaxAttempts is how many times a request is tried in total (the
+// initial attempt plus retries), overridable via HTTP_RETRY_MAX_ATTEMPTS
+// for deployments behind a flakier network.
+var retryMaxAttempts = loadRetryIntEnv("HTTP_RETRY_MAX_ATTEMPTS", 3)
+
+// retryBaseDelay is the backoff before the first retry; each subsequent
+// retry doubles it (capped at retryMaxDelay) before jitter is applied.
+var retryBaseDelay = loadRetryDurationEnv("HTTP_RETRY_BASE_DELAY_MS", 250*time.Millisecond)
+
+// retryMaxDelay caps the backoff so a high attempt count can't turn into a
+// multi-minute stall on a single source.
+var retryMaxDelay = loadRetryDurationEnv("HTTP_RETRY_MAX_DELAY_MS", 5*time.Second)
+
+// retryableStatusCodes are the HTTP statuses treated as transient. 429 is
+// deliberately excluded: quota/rate-limit errors are handled by
+// classifySourceError/the cost ledger, not blind retries.
+var retryableStatusCodes = map[int]bool{
+	http.StatusBadGateway:          true,
+	http.StatusServiceUnavailable:  true,
+	http.StatusGatewayTimeout:      true,
+	http.StatusInternalServerError: true,
+}
+
+func loadRetryIntEnv(name string, fallback int) int {
+	if raw := os.Getenv(name); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			return n
+		}
+	}
+	return fallback
+}
+
+func loadRetryDurationEnv(name string, fallback time.Duration) time.Duration {
+	if raw := os.Getenv(name); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			return time.Duration(n) * time.Millisecond
+		}
+	}
+	return fallback
+}
+
+// retryingRoundTripper retries a request with exponential backoff and full
+// jitter when it fails outright (timeout, connection reset) or comes back
+// with a retryableStatusCodes status, so a transient 502 or timeout from
+// one upstream doesn't produce an empty section for that source. It sits
+// underneath limitingRoundTripper in sharedTransport, so every fetcher
+// (NewsAPI, RSS, YouTube) gets this for free without its own retry loop.
+type retryingRoundTripper struct {
+	Base http.RoundTripper
+}
+
+func (t *retryingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A request body can only be read once; buffer it so it can be replayed
+	// on each retry attempt.
+	var bodyBytes []byte
+	if req.Body != nil {
+		var err error
+		bodyBytes, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; attempt < retryMaxAttempts; attempt++ {
+		if bodyBytes != nil {
+			req.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+		}
+
+		resp, err = t.Base.RoundTrip(req)
+		if !shouldRetry(resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		if attempt == retryMaxAttempts-1 {
+			break
+		}
+		if sleepErr := sleepForRetry(req, attempt); sleepErr != nil {
+			return resp, sleepErr
+		}
+	}
+	return resp, err
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return retryableStatusCodes[resp.StatusCode]
+}
+
+// sleepForRetry waits out the backoff for attempt (0-indexed), doubling
+// retryBaseDelay each attempt up to retryMaxDelay, then applying full
+// jitter (a random duration between 0 and the capped delay) so many
+// simultaneously-retrying fetchers don't all hammer the upstream in lockstep.
+// It returns early with req.Context().Err() if the request is canceled
+// while waiting.
+func sleepForRetry(req *http.Request, attempt int) error {
+	delay := retryBaseDelay << attempt
+	if delay > retryMaxDelay || delay <= 0 {
+		delay = retryMaxDelay
+	}
+	jittered := time.Duration(rand.Int63n(int64(delay) + 1))
+
+	timer := time.NewTimer(jittered)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
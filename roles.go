@@ -0,0 +1,60 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// restrictedSources are hidden entirely for restricted viewers, since they
+// come from social scraping rather than curated news feeds.
+var restrictedSources = map[string]bool{
+	"Twitter":   true,
+	"Instagram": true,
+	"Facebook":  true,
+}
+
+// viewerRole returns the caller's requested role. There's no session/auth
+// system yet, so the role is passed explicitly per request (header or query
+// param), matching how deployments like school/kiosk installs would pin it
+// via a reverse-proxy-injected header.
+func viewerRole(c *gin.Context) string {
+	role := c.GetHeader("X-Viewer-Role")
+	if role == "" {
+		role = c.Query("role")
+	}
+	if role == "" {
+		return "standard"
+	}
+	return role
+}
+
+func isRestrictedViewer(c *gin.Context) bool {
+	return viewerRole(c) == "restricted"
+}
+
+// applyRoleRestrictions drops sources that restricted viewers (schools,
+// shared kiosks) shouldn't see.
+func applyRoleRestrictions(results map[string][]FeedResult, restricted bool) map[string][]FeedResult {
+	if !restricted {
+		return results
+	}
+	filtered := make(map[string][]FeedResult, len(results))
+	for source, feedResults := range results {
+		if restrictedSources[source] {
+			continue
+		}
+		filtered[source] = feedResults
+	}
+	return filtered
+}
+
+// requireUnrestricted is used by handlers (alerts, exports) that restricted
+// viewers must not be able to reach at all.
+func requireUnrestricted(c *gin.Context) bool {
+	if isRestrictedViewer(c) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "this action is disabled for restricted viewers"})
+		return false
+	}
+	return true
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unsafeKeywords are crude local heuristics for adult/violent content, used
+// as a backstop for sources (like RSS) that have no native safe-search flag.
+var unsafeKeywords = []string{"nsfw", "explicit", "gore", "graphic violence"}
+
+var (
+	safeSearchEnabled = true
+	safeSearchLock    sync.RWMutex
+)
+
+func isSafeSearchEnabled() bool {
+	safeSearchLock.RLock()
+	defer safeSearchLock.RUnlock()
+	return safeSearchEnabled
+}
+
+// filterUnsafeResults drops results whose title or description match a
+// local adult/violent content heuristic when safe search is enabled. This
+// is a backstop on top of source-native flags like YouTube's safeSearch
+// parameter or Reddit's over_18 field, not a replacement for them.
+func filterUnsafeResults(results []FeedResult) []FeedResult {
+	if !isSafeSearchEnabled() {
+		return results
+	}
+
+	filtered := results[:0]
+	for _, result := range results {
+		if result.NSFW {
+			continue
+		}
+		text := strings.ToLower(result.Title + " " + result.Description)
+		flagged := false
+		for _, word := range unsafeKeywords {
+			if strings.Contains(text, word) {
+				flagged = true
+				break
+			}
+		}
+		if !flagged {
+			filtered = append(filtered, result)
+		}
+	}
+	return filtered
+}
+
+// adminSetSafeSearchHandler toggles safe-search mode for the whole
+// deployment.
+func adminSetSafeSearchHandler(c *gin.Context) {
+	var body struct {
+		Enabled bool `json:"enabled"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	safeSearchLock.Lock()
+	safeSearchEnabled = body.Enabled
+	safeSearchLock.Unlock()
+
+	c.JSON(http.StatusOK, gin.H{"safeSearch": body.Enabled})
+}
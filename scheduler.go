@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// schedulerDefaultInterval is how often the background crawler re-polls
+// every scheduled keyword when SCHEDULER_INTERVAL_SECONDS isn't set.
+const schedulerDefaultInterval = 15 * time.Minute
+
+// schedulerCrawlTimeout bounds one keyword's scheduled crawl, mirroring
+// crawlJobTTL's role for async crawl jobs: a background crawl must not run
+// forever just because one source hung.
+const schedulerCrawlTimeout = 2 * time.Minute
+
+var schedulerInterval = schedulerDefaultInterval
+
+// schedulerDefaultCatchupWindow bounds how long after a keyword's interval
+// elapses the scheduler will still treat it as "just missed" and crawl it
+// promptly. Beyond the window (e.g. the process was down for a day), the
+// crawl is skipped and the keyword's cadence is simply resumed from now —
+// otherwise a long outage would mean every scheduled keyword, including
+// ones that only matter on a slow low-priority cadence, all crawling at
+// once the moment the process comes back up.
+const schedulerDefaultCatchupWindow = 2 * time.Hour
+
+var schedulerCatchupWindow = schedulerDefaultCatchupWindow
+
+// schedulerStateFile persists lastScheduledCrawl across restarts, the same
+// JSON-file approach embeddedqueue.go uses for job_queue.json — see
+// KNOWN_DEVIATIONS.md for why this codebase uses JSON files rather than
+// SQLite for this kind of small persisted state.
+const schedulerStateFile = "scheduler_state.json"
+
+// loadSchedulerConfig applies optional SCHEDULER_INTERVAL_SECONDS and
+// SCHEDULER_CATCHUP_WINDOW_SECONDS environment overrides, following the
+// same pattern as loadEnrichmentConfig.
+func loadSchedulerConfig() {
+	if raw := os.Getenv("SCHEDULER_INTERVAL_SECONDS"); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			schedulerInterval = time.Duration(n) * time.Second
+		}
+	}
+	if raw := os.Getenv("SCHEDULER_CATCHUP_WINDOW_SECONDS"); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			schedulerCatchupWindow = time.Duration(n) * time.Second
+		}
+	}
+}
+
+// loadSchedulerState restores lastScheduledCrawl from schedulerStateFile, so
+// a restart knows how stale each keyword actually is instead of treating
+// every one of them as never crawled. Safe to call once at startup, before
+// startScheduledCrawler; a missing or unreadable file just leaves
+// lastScheduledCrawl empty, matching the original in-memory-only behavior.
+func loadSchedulerState() {
+	data, err := os.ReadFile(schedulerStateFile)
+	if err != nil {
+		return
+	}
+	var state map[string]time.Time
+	if err := json.Unmarshal(data, &state); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding scheduler state file: %s", err))
+		return
+	}
+
+	lastScheduledCrawlLock.Lock()
+	defer lastScheduledCrawlLock.Unlock()
+	lastScheduledCrawl = state
+}
+
+// saveSchedulerState persists the current lastScheduledCrawl, called
+// whenever it changes so an unexpected restart loses at most the crawls
+// since the last save rather than the whole map. Caller must hold
+// lastScheduledCrawlLock.
+func saveSchedulerState() {
+	data, err := json.MarshalIndent(lastScheduledCrawl, "", "  ")
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error encoding scheduler state file: %s", err))
+		return
+	}
+	if err := os.WriteFile(schedulerStateFile, data, 0o644); err != nil {
+		slog.Error(fmt.Sprintf("Error writing scheduler state file: %s", err))
+	}
+}
+
+// startScheduledCrawler runs the background crawl loop that keeps
+// scheduledKeywords fresh in both search history and the local search
+// index (searchindex.go), so GET /search/indexed can answer instantly
+// instead of fanning out to upstreams on every request. It is safe to call
+// once at startup.
+func startScheduledCrawler() {
+	go func() {
+		ticker := time.NewTicker(schedulerInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			runScheduledCrawl()
+		}
+	}()
+}
+
+// scheduledKeywords is every keyword the background crawler keeps warm:
+// anything on the watchlist (explicitly configured) plus anything a user
+// has searched for before, so ad hoc searches benefit from the index too
+// once they've been seen once.
+func scheduledKeywords() []string {
+	seen := make(map[string]bool)
+	var keywords []string
+
+	appDBLock.Lock()
+	for keyword := range appDB.Watchlist {
+		if !seen[keyword] {
+			seen[keyword] = true
+			keywords = append(keywords, keyword)
+		}
+	}
+	appDBLock.Unlock()
+
+	searchedKeywordsLock.Lock()
+	for keyword := range searchedKeywords {
+		if !seen[keyword] {
+			seen[keyword] = true
+			keywords = append(keywords, keyword)
+		}
+	}
+	searchedKeywordsLock.Unlock()
+
+	return keywords
+}
+
+// lastScheduledCrawl tracks when each keyword was last actually crawled,
+// so runScheduledCrawl can space out low-priority keywords across several
+// ticks instead of hitting every keyword on every tick regardless of
+// priority.
+var (
+	lastScheduledCrawl     = make(map[string]time.Time)
+	lastScheduledCrawlLock sync.Mutex
+)
+
+// runScheduledCrawl fetches every scheduled keyword that's due, one at a
+// time. fetchAllFeeds already fans out across sources for a single
+// keyword; running keywords sequentially keeps the background crawler's
+// own concurrency bounded instead of stacking a full fan-out per keyword
+// on top of each other.
+//
+// Two things gate whether a keyword's turn has come: its own priority-
+// scaled interval (crawlIntervalMultiplier) and how much of today's
+// metered-API budget is left (remainingQuotaFraction) — a naive
+// fixed-interval sweep blows through quota by noon regardless of which
+// keywords actually matter, so both must allow it before it crawls.
+//
+// lastScheduledCrawl is loaded from schedulerStateFile at startup
+// (loadSchedulerState), so a keyword that missed its interval entirely
+// while the process was down still gets crawled promptly here rather than
+// waiting a full fresh interval — but only within schedulerCatchupWindow;
+// a keyword missed by more than that is treated as freshly starting
+// instead of crawling immediately, so a long outage doesn't crawl every
+// scheduled keyword at once the moment the process comes back.
+func runScheduledCrawl() {
+	remaining := remainingQuotaFraction()
+	now := time.Now()
+
+	for _, keyword := range scheduledKeywords() {
+		entry, _ := watchlistEntryFor(keyword)
+		priority := normalizePriority(entry.Priority)
+
+		if !quotaAllowsCrawl(priority, remaining) {
+			slog.Info(fmt.Sprintf("Skipping scheduled crawl for %q: %s priority and only %.0f%% of daily quota remains", keyword, priority, remaining*100))
+			continue
+		}
+
+		interval := time.Duration(float64(schedulerInterval) * crawlIntervalMultiplier(priority))
+
+		lastScheduledCrawlLock.Lock()
+		last, seen := lastScheduledCrawl[keyword]
+		elapsed := now.Sub(last)
+		due := !seen || elapsed >= interval
+		missedTooLong := seen && elapsed > interval+schedulerCatchupWindow
+		if missedTooLong {
+			// Too stale to catch up on; resume the cadence from now
+			// instead of crawling immediately.
+			due = false
+			lastScheduledCrawl[keyword] = now
+			saveSchedulerState()
+			slog.Info(fmt.Sprintf("Resuming schedule for %q from now: missed by %s, beyond the %s catch-up window", keyword, elapsed.Round(time.Second), schedulerCatchupWindow))
+		}
+		lastScheduledCrawlLock.Unlock()
+		if !due {
+			continue
+		}
+
+		crawlScheduledKeyword(keyword, priority)
+
+		lastScheduledCrawlLock.Lock()
+		lastScheduledCrawl[keyword] = now
+		saveSchedulerState()
+		lastScheduledCrawlLock.Unlock()
+	}
+}
+
+func crawlScheduledKeyword(keyword string, priority keywordPriority) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error(fmt.Sprintf("Recovered from panic in scheduled crawl for %q: %v", keyword, r))
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), schedulerCrawlTimeout)
+	defer cancel()
+	ctx = withKeywordPriority(ctx, priority)
+
+	results := fetchAllFeeds(ctx, keyword)
+	recordSearchHistory(keyword, results)
+}
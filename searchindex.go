@@ -0,0 +1,130 @@
+package main
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// searchIndex is a hand-rolled in-memory inverted index over already-
+// crawled FeedResults, in place of the Bleve full-text index originally
+// requested (KNOWN_DEVIATIONS.md — no vendored blevesearch/bleve and no
+// network access to fetch it were available to this change). An in-memory
+// postings list is the closest honest analog for a codebase already built
+// around a JSON-file "database" and in-process caches (the `cache`
+// sync.Map in main.go) rather than external storage engines: it gives GET
+// /search/indexed the same "instant, no upstream fan-out" property a real
+// index would.
+type searchIndex struct {
+	mu        sync.RWMutex
+	postings  map[string]map[string]bool // term -> set of result IDs
+	documents map[string]FeedResult      // result ID -> result
+}
+
+func newSearchIndex() *searchIndex {
+	return &searchIndex{
+		postings:  make(map[string]map[string]bool),
+		documents: make(map[string]FeedResult),
+	}
+}
+
+// globalSearchIndex is populated as results are fetched (fetchAllFeeds) and
+// by the scheduled background crawler (scheduler.go), and read by
+// searchIndexedHandler.
+var globalSearchIndex = newSearchIndex()
+
+// Index adds or replaces results in the index, tokenizing their title and
+// description with the same significantWords tokenizer question-answering
+// relevance uses (qa.go), so "what counts as a matching term" is
+// consistent across both features.
+func (idx *searchIndex) Index(results []FeedResult) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for _, result := range results {
+		if result.ID == "" {
+			continue
+		}
+		idx.documents[result.ID] = result
+		for _, term := range significantWords(result.Title + " " + result.Description) {
+			if idx.postings[term] == nil {
+				idx.postings[term] = make(map[string]bool)
+			}
+			idx.postings[term][result.ID] = true
+		}
+	}
+}
+
+// Search returns indexed results matching keyword, ranked by how many of
+// keyword's significant words they matched, most matches first, ties
+// broken by recency.
+func (idx *searchIndex) Search(keyword string) []FeedResult {
+	terms := significantWords(keyword)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	scores := make(map[string]int)
+	for _, term := range terms {
+		for id := range idx.postings[term] {
+			scores[id]++
+		}
+	}
+
+	type scored struct {
+		result FeedResult
+		score  int
+	}
+	candidates := make([]scored, 0, len(scores))
+	for id, score := range scores {
+		if result, ok := idx.documents[id]; ok {
+			candidates = append(candidates, scored{result: result, score: score})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].result.PublishedTime.After(candidates[j].result.PublishedTime)
+	})
+
+	out := make([]FeedResult, len(candidates))
+	for i, c := range candidates {
+		out[i] = c.result
+	}
+	return out
+}
+
+// rebuildSearchIndexFromHistory repopulates globalSearchIndex from
+// appDB.History at startup. The index itself isn't persisted to disk —
+// it's cheap to rebuild from data that already is.
+func rebuildSearchIndexFromHistory() {
+	appDBLock.Lock()
+	defer appDBLock.Unlock()
+	for _, entries := range appDB.History {
+		for _, entry := range entries {
+			globalSearchIndex.Index(entry.Results)
+		}
+	}
+}
+
+// searchIndexedHandler serves GET /search/indexed?keyword=..., answering
+// straight from globalSearchIndex instead of fanning out to upstream
+// sources, so a keyword the scheduled crawler (or a previous live search)
+// has already indexed returns instantly.
+func searchIndexedHandler(c *gin.Context) {
+	keyword := c.Query("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is required"})
+		return
+	}
+
+	restricted := isRestrictedViewer(c)
+	results := applyRoleRestrictions(map[string][]FeedResult{"News": globalSearchIndex.Search(keyword)}, restricted)["News"]
+
+	c.JSON(http.StatusOK, gin.H{"keyword": keyword, "results": results, "indexed": true})
+}
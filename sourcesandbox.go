@@ -0,0 +1,69 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// sourceSandboxResult is what GET /admin/sources/:name/try returns: the raw
+// output of one source's Fetch for one keyword, before any of the
+// downstream filtering (dedupe, safe search, watchlist result limits)
+// fetchAllFeeds normally applies, so "why does this term return nothing on
+// Twitter" can be answered without wading through fetchAllFeeds's fan-out.
+type sourceSandboxResult struct {
+	Source      string       `json:"source"`
+	Keyword     string       `json:"keyword"`
+	Enabled     bool         `json:"enabled"`
+	CircuitOpen bool         `json:"circuitOpen"`
+	DurationMS  int64        `json:"durationMs"`
+	Error       string       `json:"error,omitempty"`
+	ItemCount   int          `json:"itemCount"`
+	Items       []FeedResult `json:"items"`
+}
+
+// sourceSandboxHandler serves GET /admin/sources/:name/try?keyword=..., an
+// admin-only escape hatch that calls one FeedSource's Fetch directly and
+// returns its raw output plus timing, instead of routing through
+// fetchAllFeeds's circuit breaker, caching and cross-source combining. It
+// deliberately bypasses the breaker (rather than reporting errCircuitOpen)
+// so a source that's currently tripped can still be probed by hand.
+func sourceSandboxHandler(c *gin.Context) {
+	if !requireUnrestricted(c) {
+		return
+	}
+
+	name := c.Param("name")
+	source, ok := sourceRegistry[name]
+	if !ok {
+		c.JSON(http.StatusNotFound, gin.H{"error": "unknown source " + name})
+		return
+	}
+
+	keyword := c.Query("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is required"})
+		return
+	}
+	if rejectSearchInPublicMode(c, keyword) {
+		return
+	}
+
+	start := time.Now()
+	items, err := source.Fetch(c.Request.Context(), keyword)
+	result := sourceSandboxResult{
+		Source:      name,
+		Keyword:     keyword,
+		Enabled:     isSourceEnabled(name),
+		CircuitOpen: circuitOpen(name),
+		DurationMS:  time.Since(start).Milliseconds(),
+		ItemCount:   len(items),
+		Items:       items,
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	c.JSON(http.StatusOK, result)
+}
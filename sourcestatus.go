@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// newsAPIErrorBody is the error envelope NewsAPI returns on non-200
+// responses, e.g. {"status":"error","code":"rateLimited","message":"..."}.
+type newsAPIErrorBody struct {
+	Status  string `json:"status"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// parseNewsAPIError reads and parses a non-200 NewsAPI response body,
+// returning a descriptive error that surfaces the upstream code and
+// message instead of just the HTTP status.
+func parseNewsAPIError(resp *http.Response) error {
+	body, readErr := io.ReadAll(resp.Body)
+	if readErr != nil {
+		return fmt.Errorf("news api returned status %d (body unreadable: %s)", resp.StatusCode, readErr)
+	}
+
+	var parsed newsAPIErrorBody
+	if err := json.Unmarshal(body, &parsed); err != nil || parsed.Code == "" {
+		return fmt.Errorf("news api returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return fmt.Errorf("news api error [%s]: %s (http %d)", parsed.Code, parsed.Message, resp.StatusCode)
+}
+
+// sourceStatus is the last known health of a feed source, surfaced through
+// the API so clients can distinguish "no matches" from "source failed".
+var (
+	lastSourceError     = make(map[string]string)
+	lastSourceErrorLock sync.Mutex
+)
+
+func recordSourceError(source string, err error) {
+	lastSourceErrorLock.Lock()
+	defer lastSourceErrorLock.Unlock()
+	if err == nil {
+		delete(lastSourceError, source)
+		return
+	}
+	lastSourceError[source] = err.Error()
+}
+
+func snapshotSourceErrors() map[string]string {
+	lastSourceErrorLock.Lock()
+	defer lastSourceErrorLock.Unlock()
+	snapshot := make(map[string]string, len(lastSourceError))
+	for k, v := range lastSourceError {
+		snapshot[k] = v
+	}
+	return snapshot
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"strings"
+)
+
+// sourceGroupContextKey is the context key a request's requested source
+// group (its "sources" query/form parameter) is stashed under, so
+// fetchRSSFeeds can filter news_sources.json entries by tag without the
+// FeedSource interface needing a third parameter just for this.
+type sourceGroupContextKey struct{}
+
+// withSourceGroup returns a copy of ctx carrying group, the raw "sources"
+// parameter value from a search request (e.g. "tag:finance").
+func withSourceGroup(ctx context.Context, group string) context.Context {
+	if group == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, sourceGroupContextKey{}, group)
+}
+
+// sourceGroupFromContext returns the source group stashed by
+// withSourceGroup, or "" if the request didn't request one.
+func sourceGroupFromContext(ctx context.Context) string {
+	group, _ := ctx.Value(sourceGroupContextKey{}).(string)
+	return group
+}
+
+// matchesSourceGroup reports whether cfg should be included when group is
+// the requested source group. An empty group matches everything. Only the
+// "tag:<name>" syntax is currently supported; group values without that
+// prefix match nothing, since there's no other grouping concept yet.
+func matchesSourceGroup(cfg RSSSourceConfig, group string) bool {
+	if group == "" {
+		return true
+	}
+	tag, ok := strings.CutPrefix(group, "tag:")
+	if !ok {
+		return false
+	}
+	for _, sourceTag := range cfg.Tags {
+		if strings.EqualFold(sourceTag, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterSourcesByGroup returns the subset of sources matching group.
+func filterSourcesByGroup(sources []RSSSourceConfig, group string) []RSSSourceConfig {
+	if group == "" {
+		return sources
+	}
+	filtered := make([]RSSSourceConfig, 0, len(sources))
+	for _, source := range sources {
+		if matchesSourceGroup(source, group) {
+			filtered = append(filtered, source)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// sourceStatusSummary is one source's health for /status: whether it's
+// enabled, its breaker state, and when it last actually returned results,
+// so "no Twitter results" can be read as either "quiet" or "outage".
+type sourceStatusSummary struct {
+	Name                string    `json:"name"`
+	Enabled             bool      `json:"enabled"`
+	LastError           string    `json:"lastError,omitempty"`
+	CircuitOpen         bool      `json:"circuitOpen"`
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	LastSuccessAt       time.Time `json:"lastSuccessAt,omitempty"`
+}
+
+// statusReport is the full payload GET /status renders as HTML or serves
+// as JSON.
+type statusReport struct {
+	GeneratedAt time.Time             `json:"generatedAt"`
+	Sources     []sourceStatusSummary `json:"sources"`
+	QueueDepth  int                   `json:"queueDepth"`
+}
+
+// buildStatusReport assembles the current health snapshot from the same
+// bookkeeping every source fetch already updates (sourcestatus.go,
+// circuitbreaker.go, crawljobs.go) — nothing here triggers a fresh crawl.
+func buildStatusReport() statusReport {
+	errors := snapshotSourceErrors()
+	breakers := snapshotCircuitBreakers()
+	successAt := snapshotSourceLastSuccessAt()
+
+	report := statusReport{
+		GeneratedAt: time.Now(),
+		QueueDepth:  crawlJobs.QueueDepth(),
+	}
+
+	for _, name := range sourceRegistryOrder {
+		breaker := breakers[name]
+		report.Sources = append(report.Sources, sourceStatusSummary{
+			Name:                name,
+			Enabled:             isSourceEnabled(name),
+			LastError:           errors[name],
+			CircuitOpen:         breaker.Open,
+			ConsecutiveFailures: breaker.ConsecutiveFailures,
+			LastSuccessAt:       successAt[name],
+		})
+	}
+
+	return report
+}
+
+// statusHandler serves GET /status: a shareable, read-only page (or, with
+// ?format=json, the same data as JSON for scripting/monitoring) summarizing
+// source health, breaker state and crawl job queue depth.
+func statusHandler(c *gin.Context) {
+	report := buildStatusReport()
+
+	if c.Query("format") == "json" {
+		c.JSON(http.StatusOK, report)
+		return
+	}
+
+	c.HTML(http.StatusOK, "status.html", gin.H{"report": report})
+}
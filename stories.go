@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+const followedStoriesFile = "followed_stories.json"
+const mutedStoriesFile = "muted_stories.json"
+
+var (
+	followedStories     = make(map[string]bool)
+	followedStoriesLock sync.Mutex
+
+	mutedStories     = make(map[uint64]bool)
+	mutedStoriesLock sync.RWMutex
+)
+
+func loadFollowedStories() {
+	file, err := os.Open(followedStoriesFile)
+	if err != nil {
+		slog.Info(fmt.Sprintf("No existing followed stories file found: %s", err))
+		return
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&followedStories); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding followed stories file: %s", err))
+	}
+}
+
+func saveFollowedStories() {
+	file, err := os.Create(followedStoriesFile)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error saving followed stories file: %s", err))
+		return
+	}
+	defer file.Close()
+
+	if err := json.NewEncoder(file).Encode(&followedStories); err != nil {
+		slog.Error(fmt.Sprintf("Error encoding followed stories file: %s", err))
+	}
+}
+
+func loadMutedStories() {
+	file, err := os.Open(mutedStoriesFile)
+	if err != nil {
+		slog.Info(fmt.Sprintf("No existing muted stories file found: %s", err))
+		return
+	}
+	defer file.Close()
+
+	mutedStoriesLock.Lock()
+	defer mutedStoriesLock.Unlock()
+	if err := json.NewDecoder(file).Decode(&mutedStories); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding muted stories file: %s", err))
+	}
+}
+
+func saveMutedStories() {
+	file, err := os.Create(mutedStoriesFile)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error saving muted stories file: %s", err))
+		return
+	}
+	defer file.Close()
+
+	mutedStoriesLock.RLock()
+	defer mutedStoriesLock.RUnlock()
+	if err := json.NewEncoder(file).Encode(&mutedStories); err != nil {
+		slog.Error(fmt.Sprintf("Error encoding muted stories file: %s", err))
+	}
+}
+
+// muteStoryHandler mutes a story cluster so it stops drowning out a
+// keyword's other results across all views and digests.
+func muteStoryHandler(c *gin.Context) {
+	fingerprintParam := c.Param("fingerprint")
+	target, err := strconv.ParseUint(fingerprintParam, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "fingerprint must be a numeric simhash value"})
+		return
+	}
+
+	mutedStoriesLock.Lock()
+	mutedStories[target] = true
+	saveMutedStories()
+	mutedStoriesLock.Unlock()
+
+	c.JSON(http.StatusOK, gin.H{"status": "muted", "fingerprint": target})
+}
+
+// filterMutedStories drops any result whose fingerprint is a near-duplicate
+// of a muted cluster.
+func filterMutedStories(results []FeedResult) []FeedResult {
+	mutedStoriesLock.RLock()
+	defer mutedStoriesLock.RUnlock()
+	if len(mutedStories) == 0 {
+		return results
+	}
+
+	filtered := results[:0]
+	for _, result := range results {
+		muted := false
+		for fingerprint := range mutedStories {
+			if hammingDistance64(result.Fingerprint, fingerprint) <= simhashSyndicationThreshold {
+				muted = true
+				break
+			}
+		}
+		if !muted {
+			filtered = append(filtered, result)
+		}
+	}
+	return filtered
+}
+
+// followStoryHandler subscribes the caller to a story cluster, identified by
+// the fingerprint of any item in it.
+func followStoryHandler(c *gin.Context) {
+	fingerprint := c.Param("fingerprint")
+
+	followedStoriesLock.Lock()
+	followedStories[fingerprint] = true
+	saveFollowedStories()
+	followedStoriesLock.Unlock()
+
+	c.JSON(http.StatusOK, gin.H{"status": "following", "fingerprint": fingerprint})
+}
+
+// clusterDetailHandler returns every cached item whose fingerprint is a
+// near-duplicate of the requested one, i.e. the full story cluster,
+// including new items that have joined it since the caller last checked.
+func clusterDetailHandler(c *gin.Context) {
+	fingerprintParam := c.Param("fingerprint")
+	target, err := strconv.ParseUint(fingerprintParam, 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "fingerprint must be a numeric simhash value"})
+		return
+	}
+
+	var members []FeedResult
+	cache.Range(func(key, value any) bool {
+		results, ok := value.([]FeedResult)
+		if !ok {
+			return true
+		}
+		for _, result := range results {
+			if hammingDistance64(result.Fingerprint, target) <= simhashSyndicationThreshold {
+				members = append(members, result)
+			}
+		}
+		return true
+	})
+
+	c.JSON(http.StatusOK, gin.H{"fingerprint": target, "items": members})
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// sourceResultEvent is one Server-Sent Event payload emitted as each
+// source's fetch completes.
+type sourceResultEvent struct {
+	Source  string       `json:"source"`
+	Results []FeedResult `json:"results"`
+	Error   string       `json:"error,omitempty"`
+}
+
+// streamSearchHandler serves /search/stream: it fetches every enabled
+// source concurrently, exactly like fetchAllFeeds, but emits each source's
+// results as an SSE event the moment its goroutine finishes instead of
+// waiting for the slowest one (often the chromedp-based Facebook scraper).
+//
+// This is this repo's streaming search interface. There's no separate
+// client/crawler-server split to put a gRPC service between (no gRPC
+// dependency is vendored, and this sandbox has no network access to add
+// one) — streaming, deadlines (ctx, already threaded through Fetch) and
+// typed payloads (sourceResultEvent, not an ad-hoc string) are already
+// covered here over plain HTTP/SSE.
+func streamSearchHandler(c *gin.Context) {
+	keyword := c.Query("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is required"})
+		return
+	}
+	if rejectSearchInPublicMode(c, keyword) {
+		return
+	}
+
+	restricted := isRestrictedViewer(c)
+	ctx := withSourceGroup(c.Request.Context(), c.Query("sources"))
+	events := make(chan sourceResultEvent)
+
+	go streamAllFeeds(ctx, keyword, restricted, events)
+
+	c.Writer.Header().Set("Content-Type", "text/event-stream")
+	c.Writer.Header().Set("Cache-Control", "no-cache")
+	c.Writer.Header().Set("Connection", "keep-alive")
+
+	c.Stream(func(w io.Writer) bool {
+		event, ok := <-events
+		if !ok {
+			return false
+		}
+		payload, err := json.Marshal(event)
+		if err != nil {
+			slog.Error(fmt.Sprintf("Error encoding SSE event for %s: %s", event.Source, err))
+			return true
+		}
+		c.SSEvent("result", string(payload))
+		return true
+	})
+}
+
+// streamAllFeeds mirrors fetchAllFeeds' per-source fan-out but sends each
+// source's results down events as soon as it finishes, then closes events
+// once every enabled source (including the synthetic "News" merge) has
+// reported.
+func streamAllFeeds(ctx context.Context, keyword string, restricted bool, events chan<- sourceResultEvent) {
+	defer close(events)
+
+	sources := enabledSources()
+	pending := len(sources)
+	if pending == 0 {
+		return
+	}
+
+	perSource := make(chan sourceResultEvent, pending)
+	for _, source := range sources {
+		go func(source FeedSource) {
+			defer func() {
+				if r := recover(); r != nil {
+					slog.Error(fmt.Sprintf("Recovered from panic in %s feed fetch: %v", source.Name(), r))
+					perSource <- sourceResultEvent{Source: source.Name(), Error: "internal error"}
+				}
+			}()
+			results, err := source.Fetch(ctx, keyword)
+			event := sourceResultEvent{Source: source.Name(), Results: results}
+			if err != nil {
+				event.Error = err.Error()
+			}
+			perSource <- event
+		}(source)
+	}
+
+	var newsResults []FeedResult
+	for i := 0; i < pending; i++ {
+		event := <-perSource
+		if event.Source == "NewsAPI" || event.Source == "RSS" {
+			newsResults = append(newsResults, event.Results...)
+		}
+		event.Results = applyRoleRestrictions(map[string][]FeedResult{event.Source: event.Results}, restricted)[event.Source]
+		event.Results = annotateStableIDs(event.Source, annotateTextMetadata(event.Results))
+		events <- event
+	}
+
+	news := filterMutedStories(annotateVelocity(annotateFingerprints(dedupeNewsResults(newsResults))))
+	events <- sourceResultEvent{Source: "News", Results: annotateStableIDs("News", annotateTextMetadata(filterUnsafeResults(news)))}
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// summaryCacheFile persists per-article summaries keyed by a hash of their
+// content, the same "hash the text, cache the LLM output" shape
+// translation.go uses for translations — a widely-syndicated article
+// shouldn't be re-summarized (and re-billed) every time it's fetched.
+const summaryCacheFile = "summary_cache.json"
+
+var (
+	summaryCache     = make(map[string]string)
+	summaryCacheLock sync.Mutex
+)
+
+func loadSummaryCache() {
+	summaryCacheLock.Lock()
+	defer summaryCacheLock.Unlock()
+
+	file, err := os.Open(summaryCacheFile)
+	if err != nil {
+		slog.Info(fmt.Sprintf("No existing summary cache file found: %s", err))
+		return
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&summaryCache); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding summary cache: %s", err))
+	}
+}
+
+func saveSummaryCache() {
+	summaryCacheLock.Lock()
+	defer summaryCacheLock.Unlock()
+
+	file, err := os.Create(summaryCacheFile)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error saving summary cache: %s", err))
+		return
+	}
+	defer file.Close()
+
+	if err := json.NewEncoder(file).Encode(summaryCache); err != nil {
+		slog.Error(fmt.Sprintf("Error encoding summary cache: %s", err))
+	}
+}
+
+func summaryCacheKey(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+// summarizeArticle summarizes one article's title+description, preferring
+// a cached summary keyed by content hash over calling the LLM backend
+// again.
+func summarizeArticle(ctx context.Context, result FeedResult) (string, error) {
+	text := result.Title + "\n" + result.Description
+	key := summaryCacheKey(text)
+
+	summaryCacheLock.Lock()
+	if cached, ok := summaryCache[key]; ok {
+		summaryCacheLock.Unlock()
+		return cached, nil
+	}
+	summaryCacheLock.Unlock()
+
+	summary, err := callSummarizationLLM(ctx, text)
+	if err != nil {
+		return "", err
+	}
+
+	summaryCacheLock.Lock()
+	summaryCache[key] = summary
+	summaryCacheLock.Unlock()
+	saveSummaryCache()
+
+	return summary, nil
+}
+
+// callSummarizationLLM reuses the same OpenAI-compatible chat completions
+// shape askLLM (qa.go) and callTranslationLLM (translation.go) talk to.
+func callSummarizationLLM(ctx context.Context, text string) (string, error) {
+	reqBody := llmChatRequest{
+		Model: appConfig.LLMModel,
+		Messages: []llmChatMessage{
+			{Role: "system", Content: "Summarize the following article in one or two sentences."},
+			{Role: "user", Content: text},
+		},
+	}
+	payload, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("error encoding summarization request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, appConfig.LLMAPIBaseURL+"/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("error building summarization request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+appConfig.LLMAPIKey)
+
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error calling LLM backend for summarization: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var parsed llmChatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", fmt.Errorf("error decoding summarization response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		if parsed.Error != nil {
+			return "", fmt.Errorf("LLM backend error (%d): %s", resp.StatusCode, parsed.Error.Message)
+		}
+		return "", fmt.Errorf("LLM backend returned status %d", resp.StatusCode)
+	}
+	if len(parsed.Choices) == 0 {
+		return "", fmt.Errorf("LLM backend returned no choices")
+	}
+	return parsed.Choices[0].Message.Content, nil
+}
+
+// dailySummaryState tracks, per keyword, how far a daily summary has
+// already processed, so the next call only summarizes items published
+// since then instead of redoing the whole history every day.
+type dailySummaryState struct {
+	LastProcessedAt time.Time `json:"lastProcessedAt"`
+	LastSummary     string    `json:"lastSummary"`
+}
+
+const dailySummaryStateFile = "daily_summary_state.json"
+
+var (
+	dailySummaryStates     = make(map[string]*dailySummaryState)
+	dailySummaryStatesLock sync.Mutex
+)
+
+func loadDailySummaryStates() {
+	dailySummaryStatesLock.Lock()
+	defer dailySummaryStatesLock.Unlock()
+
+	file, err := os.Open(dailySummaryStateFile)
+	if err != nil {
+		slog.Info(fmt.Sprintf("No existing daily summary state file found: %s", err))
+		return
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&dailySummaryStates); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding daily summary state file: %s", err))
+	}
+}
+
+func saveDailySummaryStates() {
+	dailySummaryStatesLock.Lock()
+	defer dailySummaryStatesLock.Unlock()
+
+	file, err := os.Create(dailySummaryStateFile)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error saving daily summary state file: %s", err))
+		return
+	}
+	defer file.Close()
+
+	if err := json.NewEncoder(file).Encode(dailySummaryStates); err != nil {
+		slog.Error(fmt.Sprintf("Error encoding daily summary state file: %s", err))
+	}
+}
+
+// dailySummaryHandler serves GET /keywords/:keyword/summary/daily,
+// summarizing only results published since the keyword's last daily
+// summary — reusing questionAnswerHandler's gating (llm_summarization
+// feature flag plus a configured LLM_API_KEY) since both endpoints spend
+// the same LLM budget.
+func dailySummaryHandler(c *gin.Context) {
+	keyword := c.Param("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is required"})
+		return
+	}
+	if !isFeatureEnabled("llm_summarization", keyword) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "llm_summarization feature flag is disabled"})
+		return
+	}
+	if appConfig == nil || appConfig.LLMAPIKey == "" {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "LLM_API_KEY is not configured"})
+		return
+	}
+
+	appDBLock.Lock()
+	entries := appDB.History[keyword]
+	appDBLock.Unlock()
+	if len(entries) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "no stored results for this keyword"})
+		return
+	}
+
+	dailySummaryStatesLock.Lock()
+	state, ok := dailySummaryStates[keyword]
+	if !ok {
+		state = &dailySummaryState{}
+		dailySummaryStates[keyword] = state
+	}
+	since := state.LastProcessedAt
+	dailySummaryStatesLock.Unlock()
+
+	var newItems []FeedResult
+	seen := make(map[string]bool)
+	for _, entry := range entries {
+		for _, result := range entry.Results {
+			if result.ID == "" || seen[result.ID] || !result.PublishedTime.After(since) {
+				continue
+			}
+			seen[result.ID] = true
+			newItems = append(newItems, result)
+		}
+	}
+
+	if len(newItems) == 0 {
+		c.JSON(http.StatusOK, gin.H{"keyword": keyword, "since": since, "newItemsProcessed": 0, "summary": state.LastSummary})
+		return
+	}
+
+	sort.Slice(newItems, func(i, j int) bool { return newItems[i].PublishedTime.Before(newItems[j].PublishedTime) })
+
+	var itemSummaries []string
+	var latest time.Time
+	for _, item := range newItems {
+		summary, err := summarizeArticle(c.Request.Context(), item)
+		if err != nil {
+			slog.Error(fmt.Sprintf("Error summarizing article %q for daily summary of %q: %s", item.ID, keyword, err))
+			continue
+		}
+		itemSummaries = append(itemSummaries, summary)
+		if item.PublishedTime.After(latest) {
+			latest = item.PublishedTime
+		}
+	}
+
+	digest, err := callSummarizationLLM(c.Request.Context(), fmt.Sprintf(
+		"Combine these article summaries about %q into one short daily briefing paragraph:\n%s", keyword, joinLines(itemSummaries)))
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+
+	dailySummaryStatesLock.Lock()
+	state.LastProcessedAt = latest
+	state.LastSummary = digest
+	dailySummaryStatesLock.Unlock()
+	saveDailySummaryStates()
+
+	c.JSON(http.StatusOK, gin.H{
+		"keyword":           keyword,
+		"since":             since,
+		"newItemsProcessed": len(newItems),
+		"summary":           digest,
+	})
+}
+
+func joinLines(lines []string) string {
+	var joined string
+	for i, line := range lines {
+		if i > 0 {
+			joined += "\n"
+		}
+		joined += "- " + line
+	}
+	return joined
+}
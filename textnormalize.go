@@ -0,0 +1,80 @@
+package main
+
+import "unicode"
+
+// isCombiningOrJoiner reports whether r should stay attached to the
+// preceding rune rather than start a new grapheme — combining marks,
+// zero-width joiners and variation selectors are the common ways plain
+// rune-counting truncation splits an emoji or accented character in half.
+const (
+	zeroWidthJoiner       rune = 0x200D
+	variationSelectorLow  rune = 0xFE00
+	variationSelectorHigh rune = 0xFE0F
+)
+
+func isCombiningOrJoiner(r rune) bool {
+	return unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Mc, r) ||
+		r == zeroWidthJoiner ||
+		(r >= variationSelectorLow && r <= variationSelectorHigh)
+}
+
+// truncateGraphemeSafe truncates text to at most maxRunes base runes,
+// extending the cut point to include any trailing combining marks or
+// joiners so multi-rune emoji and accented characters aren't split. This
+// isn't full Unicode grapheme cluster segmentation (this repo has no such
+// dependency), but it covers the sequences that actually show up in social
+// post text.
+func truncateGraphemeSafe(text string, maxRunes int) string {
+	runes := []rune(text)
+	if len(runes) <= maxRunes {
+		return text
+	}
+
+	cut := maxRunes
+	for cut < len(runes) && isCombiningOrJoiner(runes[cut]) {
+		cut++
+	}
+	return string(runes[:cut])
+}
+
+// isRTLRune reports whether r belongs to a right-to-left script (Hebrew or
+// Arabic, the two the aggregator actually sees in social/news results).
+func isRTLRune(r rune) bool {
+	return unicode.Is(unicode.Hebrew, r) || unicode.Is(unicode.Arabic, r)
+}
+
+// textDirection returns "rtl" if text is predominantly right-to-left script
+// and "ltr" otherwise, so the frontend can set dir="rtl" instead of
+// rendering Arabic/Hebrew snippets in the wrong reading direction.
+func textDirection(text string) string {
+	var rtlCount, ltrCount int
+	for _, r := range text {
+		switch {
+		case isRTLRune(r):
+			rtlCount++
+		case unicode.IsLetter(r):
+			ltrCount++
+		}
+	}
+	if rtlCount > ltrCount {
+		return "rtl"
+	}
+	return "ltr"
+}
+
+// maxDescriptionSnippetRunes bounds how long a result's description gets
+// before display, applied grapheme-safely so heavy-emoji or RTL-script
+// posts don't get cut mid-character.
+const maxDescriptionSnippetRunes = 280
+
+// annotateTextMetadata sets each result's reading direction and truncates
+// its description to a safe snippet length, so templates and API
+// responses don't need to reimplement grapheme-aware truncation
+// themselves.
+func annotateTextMetadata(results []FeedResult) []FeedResult {
+	for i := range results {
+		results[i].Direction = textDirection(results[i].Title + " " + results[i].Description)
+		results[i].Description = truncateGraphemeSafe(results[i].Description, maxDescriptionSnippetRunes)
+	}
+	return results
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"math"
+	"regexp"
+	"strings"
+)
+
+var tokenPattern = regexp.MustCompile(`[a-z0-9]+`)
+
+// tokenize lowercases text and splits it into words, dropping punctuation
+// entirely — good enough for a bag-of-words scorer, which is all BM25
+// needs.
+func tokenize(text string) []string {
+	return tokenPattern.FindAllString(strings.ToLower(text), -1)
+}
+
+// BM25's standard tuning constants: k1 controls term-frequency saturation
+// (how much repeating a term keeps helping the score), b controls how much
+// document length is normalized against the corpus average.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// computeRelevanceScores scores every result in results against keyword
+// using BM25 over each result's title+description. There's no separate
+// reference corpus to draw document frequencies from, so results
+// themselves are treated as the corpus — the standard approach for
+// scoring one batch of search results against each other. Returns scores
+// keyed by result ID; a result sharing no terms with keyword scores 0.
+func computeRelevanceScores(results []FeedResult, keyword string) map[string]float64 {
+	scores := make(map[string]float64, len(results))
+	queryTerms := tokenize(keyword)
+	if len(queryTerms) == 0 || len(results) == 0 {
+		return scores
+	}
+
+	docTokens := make([][]string, len(results))
+	docFreq := make(map[string]int)
+	var totalLength float64
+	for i, result := range results {
+		tokens := tokenize(result.Title + " " + result.Description)
+		docTokens[i] = tokens
+		totalLength += float64(len(tokens))
+
+		seen := make(map[string]bool, len(tokens))
+		for _, term := range tokens {
+			if !seen[term] {
+				seen[term] = true
+				docFreq[term]++
+			}
+		}
+	}
+	avgLength := totalLength / float64(len(results))
+	n := float64(len(results))
+
+	for i, result := range results {
+		termFreq := make(map[string]int, len(docTokens[i]))
+		for _, term := range docTokens[i] {
+			termFreq[term]++
+		}
+
+		docLength := float64(len(docTokens[i]))
+		var score float64
+		for _, term := range queryTerms {
+			tf := float64(termFreq[term])
+			if tf == 0 {
+				continue
+			}
+			df := float64(docFreq[term])
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			score += idf * (tf * (bm25K1 + 1)) / (tf + bm25K1*(1-bm25B+bm25B*docLength/avgLength))
+		}
+		scores[result.ID] = score
+	}
+
+	return scores
+}
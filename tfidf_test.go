@@ -0,0 +1,44 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	got := tokenize("Go v1.24, Rust & C++!")
+	want := []string{"go", "v1", "24", "rust", "c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("tokenize() = %v, want %v", got, want)
+	}
+}
+
+func TestComputeRelevanceScoresRanksExactMatchHighest(t *testing.T) {
+	results := []FeedResult{
+		{ID: "1", Title: "golang releases new version", Description: "the go team shipped an update"},
+		{ID: "2", Title: "gardening tips for spring", Description: "how to plant tomatoes"},
+		{ID: "3", Title: "golang golang golang", Description: "go go go go go"},
+	}
+
+	scores := computeRelevanceScores(results, "golang")
+
+	if scores["3"] <= scores["1"] {
+		t.Fatalf("expected result 3 (repeated term) to outscore result 1: got %v", scores)
+	}
+	if scores["1"] <= scores["2"] {
+		t.Fatalf("expected result 1 (matching term) to outscore result 2 (no match): got %v", scores)
+	}
+	if scores["2"] != 0 {
+		t.Fatalf("expected a result sharing no terms with the keyword to score 0, got %v", scores["2"])
+	}
+}
+
+func TestComputeRelevanceScoresEmptyInputs(t *testing.T) {
+	if scores := computeRelevanceScores(nil, "golang"); len(scores) != 0 {
+		t.Fatalf("expected no scores for an empty result set, got %v", scores)
+	}
+	results := []FeedResult{{ID: "1", Title: "golang"}}
+	if scores := computeRelevanceScores(results, ""); len(scores) != 0 {
+		t.Fatalf("expected no scores for an empty keyword, got %v", scores)
+	}
+}
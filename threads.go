@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/chromedp/chromedp"
+)
+
+// fetchThreadsFeeds searches Threads' public web search for keyword.
+// Threads has no public search API of its own (the Threads API Meta
+// publishes only covers a developer's own posts, not keyword search
+// across the platform), so — like TikTok and LinkedIn — this is a
+// scraping source run in this same process, there being no separate
+// crawler server in this repo.
+func fetchThreadsFeeds(ctx context.Context, keyword string) []FeedResult {
+	searchURL := "https://www.threads.net/search?q=" + url.QueryEscape(keyword) + "&serp_type=default"
+
+	ctx, cancel := chromedp.NewContext(ctx)
+	defer cancel()
+
+	var htmlContent string
+	err := chromedp.Run(ctx,
+		chromedp.Navigate(searchURL),
+		chromedp.Sleep(3*time.Second),
+		chromedp.OuterHTML("body", &htmlContent),
+	)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error scraping Threads search page for %q: %s", keyword, err))
+		return nil
+	}
+
+	return parseThreadsSearchPage(htmlContent, keyword)
+}
+
+// parseThreadsSearchPage extracts post links from a rendered Threads
+// search page. Threads post URLs follow /@handle/post/shortcode, which is
+// enough to recover an author without needing to parse rendered post text.
+func parseThreadsSearchPage(htmlContent, keyword string) []FeedResult {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlContent))
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error parsing Threads search page: %s", err))
+		return nil
+	}
+
+	var results []FeedResult
+	seen := make(map[string]bool)
+	doc.Find(`a[href*="/post/"]`).Each(func(_ int, sel *goquery.Selection) {
+		href, ok := sel.Attr("href")
+		if !ok || seen[href] {
+			return
+		}
+		seen[href] = true
+
+		link := href
+		if strings.HasPrefix(link, "/") {
+			link = "https://www.threads.net" + link
+		}
+
+		author := ""
+		if parts := strings.Split(strings.TrimPrefix(href, "/"), "/"); len(parts) > 0 && strings.HasPrefix(parts[0], "@") {
+			author = strings.TrimPrefix(parts[0], "@")
+		}
+
+		results = append(results, FeedResult{
+			Title:         fmt.Sprintf("Threads post matching %q", keyword),
+			Link:          link,
+			Published:     time.Now().Format("2006-01-02 15:04:05"),
+			PublishedTime: time.Now(),
+			Description:   author,
+			Source:        "Threads",
+		})
+	})
+	return results
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/chromedp/chromedp"
+)
+
+// fetchTikTokFeeds searches TikTok's public search page for keyword.
+// TikTok has no public search API (its official Content Posting API only
+// covers a developer's own account, not keyword search), so this is a
+// scraping source, the same in-process chromedp approach
+// fetchInstagramFeedsByScraping and fetchFacebookFeedsByScraping use —
+// there's no separate crawler server in this repo to hand it to.
+func fetchTikTokFeeds(ctx context.Context, keyword string) []FeedResult {
+	searchURL := "https://www.tiktok.com/search?q=" + url.QueryEscape(keyword)
+
+	ctx, cancel := chromedp.NewContext(ctx)
+	defer cancel()
+
+	var htmlContent string
+	err := chromedp.Run(ctx,
+		chromedp.Navigate(searchURL),
+		chromedp.Sleep(3*time.Second),
+		chromedp.OuterHTML("html", &htmlContent),
+	)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error scraping TikTok search page for %q: %s", keyword, err))
+		return nil
+	}
+
+	return parseTikTokSearchPage(htmlContent, keyword)
+}
+
+// tiktokSigiState mirrors the subset of TikTok's embedded "SIGI_STATE" JSON
+// blob (a <script id="SIGI_STATE"> tag on rendered pages) this aggregator
+// needs. Parsing it, when present, gets a caption, author, and thumbnail
+// that a plain anchor-tag scrape can't recover.
+type tiktokSigiState struct {
+	ItemModule map[string]struct {
+		ID     string `json:"id"`
+		Desc   string `json:"desc"`
+		Author string `json:"author"`
+		Video  struct {
+			Cover string `json:"cover"`
+		} `json:"video"`
+		CreateTime string `json:"createTime"`
+	} `json:"ItemModule"`
+}
+
+// parseTikTokSearchPage prefers the embedded SIGI_STATE JSON for full
+// video metadata, falling back to bare video links (matching
+// parseInstagramHashtagPage's link-only fallback) if TikTok changes its
+// page structure and that script tag isn't found.
+func parseTikTokSearchPage(htmlContent, keyword string) []FeedResult {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlContent))
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error parsing TikTok search page: %s", err))
+		return nil
+	}
+
+	if raw := doc.Find(`script#SIGI_STATE`).Text(); raw != "" {
+		var state tiktokSigiState
+		if err := json.Unmarshal([]byte(raw), &state); err == nil && len(state.ItemModule) > 0 {
+			return tikTokResultsFromSigiState(state)
+		}
+	}
+
+	slog.Warn("TikTok SIGI_STATE not found or unparseable, falling back to bare video links")
+	return tikTokResultsFromLinks(doc, keyword)
+}
+
+func tikTokResultsFromSigiState(state tiktokSigiState) []FeedResult {
+	var results []FeedResult
+	for _, item := range state.ItemModule {
+		published := time.Now()
+		if item.CreateTime != "" {
+			if seconds, err := time.Parse("2006-01-02T15:04:05Z", item.CreateTime); err == nil {
+				published = seconds
+			}
+		}
+		results = append(results, FeedResult{
+			ID:            item.ID,
+			Title:         fmt.Sprintf("TikTok video by @%s", item.Author),
+			Link:          fmt.Sprintf("https://www.tiktok.com/@%s/video/%s", item.Author, item.ID),
+			Published:     published.Format("2006-01-02 15:04:05"),
+			PublishedTime: published,
+			Description:   item.Desc,
+			Source:        "TikTok",
+			Thumbnail:     item.Video.Cover,
+		})
+	}
+	return results
+}
+
+func tikTokResultsFromLinks(doc *goquery.Document, keyword string) []FeedResult {
+	var results []FeedResult
+	seen := make(map[string]bool)
+	doc.Find(`a[href*="/video/"]`).Each(func(_ int, sel *goquery.Selection) {
+		href, ok := sel.Attr("href")
+		if !ok || seen[href] {
+			return
+		}
+		seen[href] = true
+
+		author := ""
+		if parts := strings.Split(href, "/"); len(parts) > 1 && strings.HasPrefix(parts[1], "@") {
+			author = strings.TrimPrefix(parts[1], "@")
+		}
+
+		results = append(results, FeedResult{
+			Title:         fmt.Sprintf("TikTok video matching %q", keyword),
+			Link:          href,
+			Published:     time.Now().Format("2006-01-02 15:04:05"),
+			PublishedTime: time.Now(),
+			Description:   author,
+			Source:        "TikTok",
+		})
+	})
+	return results
+}
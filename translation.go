@@ -0,0 +1,220 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// TranslateTargetLanguage, if set, is the fully-realized translation stage
+// enrichment.go's comment anticipated but never had a backend for. Left
+// empty, translation is disabled and enrichTranslation is a no-op — the
+// same "empty config disables the feature" convention LLMAPIKey and
+// SentimentAPIURL use.
+var translateTargetLanguage string
+
+func loadTranslationConfig() {
+	translateTargetLanguage = os.Getenv("TRANSLATE_TARGET_LANGUAGE")
+	loadTranslationGlossary()
+	loadTranslationCache()
+}
+
+// translationGlossaryFile lists terms (brand names, product terms) that
+// must survive translation unchanged — an LLM asked to translate a
+// paragraph will otherwise happily "helpfully" translate a proper noun.
+const translationGlossaryFile = "translation_glossary.json"
+
+var (
+	translationGlossary     []string
+	translationGlossaryLock sync.Mutex
+)
+
+func loadTranslationGlossary() {
+	translationGlossaryLock.Lock()
+	defer translationGlossaryLock.Unlock()
+
+	file, err := os.Open(translationGlossaryFile)
+	if os.IsNotExist(err) {
+		translationGlossary = nil
+		return
+	}
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error opening translation glossary: %s", err))
+		return
+	}
+	defer file.Close()
+
+	var data struct {
+		Terms []string `json:"terms"`
+	}
+	if err := json.NewDecoder(file).Decode(&data); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding translation glossary: %s", err))
+		return
+	}
+	translationGlossary = data.Terms
+}
+
+// translationCacheFile persists translations keyed by a hash of the source
+// text plus target language, so identical snippets seen across crawls (a
+// widely-syndicated wire story, a repeated boilerplate line) aren't
+// re-translated — and re-billed — every time they're fetched.
+const translationCacheFile = "translation_cache.json"
+
+var (
+	translationCache     = make(map[string]string)
+	translationCacheLock sync.Mutex
+)
+
+func loadTranslationCache() {
+	translationCacheLock.Lock()
+	defer translationCacheLock.Unlock()
+
+	file, err := os.Open(translationCacheFile)
+	if err != nil {
+		slog.Info(fmt.Sprintf("No existing translation cache file found: %s", err))
+		return
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(file).Decode(&translationCache); err != nil {
+		slog.Error(fmt.Sprintf("Error decoding translation cache: %s", err))
+	}
+}
+
+func saveTranslationCache() {
+	translationCacheLock.Lock()
+	defer translationCacheLock.Unlock()
+
+	file, err := os.Create(translationCacheFile)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error saving translation cache: %s", err))
+		return
+	}
+	defer file.Close()
+
+	if err := json.NewEncoder(file).Encode(translationCache); err != nil {
+		slog.Error(fmt.Sprintf("Error encoding translation cache: %s", err))
+	}
+}
+
+// translationCacheKey hashes text plus targetLanguage, since the same
+// source text translated to two different languages must not collide.
+func translationCacheKey(text, targetLanguage string) string {
+	sum := sha256.Sum256([]byte(targetLanguage + "\x00" + text))
+	return hex.EncodeToString(sum[:])
+}
+
+// translateText translates text to translateTargetLanguage, preferring a
+// cached translation and falling back to the configured LLM backend
+// (qa.go's askLLM uses the same appConfig.LLM* backend for a different
+// purpose) on a cache miss. An empty text or unconfigured target language
+// or LLM key is a no-op, returning text unchanged rather than erroring —
+// translation degrades to "leave it alone," not "fail the search."
+func translateText(ctx context.Context, text string) (string, error) {
+	if text == "" || translateTargetLanguage == "" || appConfig == nil || appConfig.LLMAPIKey == "" {
+		return text, nil
+	}
+
+	key := translationCacheKey(text, translateTargetLanguage)
+	translationCacheLock.Lock()
+	if cached, ok := translationCache[key]; ok {
+		translationCacheLock.Unlock()
+		return cached, nil
+	}
+	translationCacheLock.Unlock()
+
+	translated, err := callTranslationLLM(ctx, text, translateTargetLanguage, translationGlossary)
+	if err != nil {
+		return text, err
+	}
+
+	translationCacheLock.Lock()
+	translationCache[key] = translated
+	translationCacheLock.Unlock()
+	saveTranslationCache()
+
+	recordCost("translation_char", translateTargetLanguage, float64(len(text)))
+	return translated, nil
+}
+
+// callTranslationLLM asks the configured LLM backend for a translation,
+// reusing the same OpenAI-compatible chat completions shape askLLM (qa.go)
+// talks to.
+func callTranslationLLM(ctx context.Context, text, targetLanguage string, glossary []string) (string, error) {
+	system := fmt.Sprintf("Translate the user's text to %s. Respond with only the translation, no commentary.", targetLanguage)
+	if len(glossary) > 0 {
+		system += fmt.Sprintf(" Leave these terms untranslated exactly as written: %s.", strings.Join(glossary, ", "))
+	}
+
+	reqBody := llmChatRequest{
+		Model: appConfig.LLMModel,
+		Messages: []llmChatMessage{
+			{Role: "system", Content: system},
+			{Role: "user", Content: text},
+		},
+	}
+	payload, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("error encoding translation request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, appConfig.LLMAPIBaseURL+"/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("error building translation request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+appConfig.LLMAPIKey)
+
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error calling LLM backend for translation: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var parsed llmChatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", fmt.Errorf("error decoding translation response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		if parsed.Error != nil {
+			return "", fmt.Errorf("LLM backend error (%d): %s", resp.StatusCode, parsed.Error.Message)
+		}
+		return "", fmt.Errorf("LLM backend returned status %d", resp.StatusCode)
+	}
+	if len(parsed.Choices) == 0 {
+		return "", fmt.Errorf("LLM backend returned no choices")
+	}
+	return parsed.Choices[0].Message.Content, nil
+}
+
+// enrichTranslation is the enrichment.go stage this feature registers
+// itself as. It's a no-op (returns result unchanged) whenever translation
+// isn't configured, so adding it to enrichmentStages costs nothing for
+// deployments that never set TRANSLATE_TARGET_LANGUAGE.
+func enrichTranslation(ctx context.Context, result FeedResult) FeedResult {
+	if translateTargetLanguage == "" {
+		return result
+	}
+	if translated, err := translateText(ctx, result.Title); err == nil {
+		result.TranslatedTitle = translated
+	} else {
+		slog.Warn(fmt.Sprintf("Error translating result title %q: %s", result.ID, err))
+	}
+	if translated, err := translateText(ctx, result.Description); err == nil {
+		result.TranslatedDescription = translated
+	} else {
+		slog.Warn(fmt.Sprintf("Error translating result description %q: %s", result.ID, err))
+	}
+	if result.TranslatedTitle != "" || result.TranslatedDescription != "" {
+		result = recordTransformation(result, "translation")
+	}
+	return result
+}
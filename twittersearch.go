@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// twitterRecentSearchURL is the X API v2 recent-search endpoint. It replaces
+// the old go-twitter v1.1 GET statuses/user_timeline calls: v1.1 could only
+// page through a fixed handle's own timeline, so matching a keyword meant
+// fetching everything and filtering locally (plan.matchesResidualFilter).
+// v2 recent search takes a real boolean query, so the same plan.TwitterQuery
+// RSS already produces is sent straight to Twitter instead.
+const twitterRecentSearchURL = "https://api.twitter.com/2/tweets/search/recent"
+
+type twitterV2SearchResponse struct {
+	Data []struct {
+		ID            string `json:"id"`
+		Text          string `json:"text"`
+		AuthorID      string `json:"author_id"`
+		CreatedAt     string `json:"created_at"`
+		PublicMetrics struct {
+			LikeCount    int `json:"like_count"`
+			RetweetCount int `json:"retweet_count"`
+			ReplyCount   int `json:"reply_count"`
+		} `json:"public_metrics"`
+	} `json:"data"`
+	Includes struct {
+		Users []struct {
+			ID              string `json:"id"`
+			Username        string `json:"username"`
+			ProfileImageURL string `json:"profile_image_url"`
+		} `json:"users"`
+	} `json:"includes"`
+	Errors []struct {
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+	} `json:"errors"`
+}
+
+// buildTwitterV2Query narrows plan's boolean query to handles when any are
+// configured (from:h1 OR from:h2 ...), or searches the whole public recent
+// index when handles is empty — the keyword-search behavior v1.1's
+// timeline-only approach couldn't offer at all.
+func buildTwitterV2Query(plan QueryPlan, handles []string) string {
+	if len(handles) == 0 {
+		return plan.TwitterQuery
+	}
+	fromClauses := make([]string, len(handles))
+	for i, handle := range handles {
+		fromClauses[i] = "from:" + strings.TrimPrefix(handle, "@")
+	}
+	return fmt.Sprintf("(%s) (%s)", plan.TwitterQuery, strings.Join(fromClauses, " OR "))
+}
+
+// fetchTwitterFeedsFromHandles runs an X API v2 recent-search for keyword,
+// scoped to handles when any are configured, using the bearer token from
+// TWITTER_BEARER_TOKEN (config.go); it then merges in each configured
+// list's timeline (see fetchTwitterListFeed). It logs the response's
+// rate-limit headers so an operator running low on the recent-search quota
+// shows up in logs before requests start failing outright.
+func fetchTwitterFeedsFromHandles(ctx context.Context, handles, lists []string, keyword string) ([]FeedResult, error) {
+	plan := compileQueryPlan(keyword)
+	query := buildTwitterV2Query(plan, handles)
+	slog.Info(fmt.Sprintf("Searching Twitter recent search with query: %s", query))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, twitterRecentSearchURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error building Twitter search request: %w", err)
+	}
+	q := url.Values{}
+	q.Set("query", query)
+	q.Set("max_results", "10")
+	q.Set("tweet.fields", "created_at,author_id,public_metrics")
+	q.Set("expansions", "author_id")
+	q.Set("user.fields", "profile_image_url,username")
+	req.URL.RawQuery = q.Encode()
+	req.Header.Set("Authorization", "Bearer "+appConfig.TwitterBearerToken)
+
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling Twitter recent search: %w", err)
+	}
+	defer resp.Body.Close()
+
+	logTwitterRateLimitHeaders(resp)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, fmt.Errorf("twitter recent search rate limited (429), resets at %s", resp.Header.Get("x-rate-limit-reset"))
+	}
+
+	var parsed twitterV2SearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("error decoding Twitter recent search response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		if len(parsed.Errors) > 0 {
+			return nil, fmt.Errorf("twitter recent search failed (%d): %s", resp.StatusCode, parsed.Errors[0].Detail)
+		}
+		return nil, fmt.Errorf("twitter recent search failed with status %d", resp.StatusCode)
+	}
+
+	results := twitterV2ResponseToResults(parsed)
+
+	for _, listID := range lists {
+		listResults, err := fetchTwitterListFeed(ctx, listID, plan)
+		if err != nil {
+			slog.Error(fmt.Sprintf("Error fetching Twitter list %s: %s", listID, err))
+			continue
+		}
+		results = append(results, listResults...)
+	}
+	return results, nil
+}
+
+// twitterListTweetsURLTemplate is the v2 endpoint for a List's timeline. It
+// takes no query parameter (unlike recent search), so results are filtered
+// locally against plan's boolean query — the same residual-filter approach
+// the old v1.1 per-handle timeline fetch used before recent search existed.
+const twitterListTweetsURLTemplate = "https://api.twitter.com/2/lists/%s/tweets"
+
+// fetchTwitterListFeed fetches one X List's recent tweets and keeps only
+// those matching plan.
+func fetchTwitterListFeed(ctx context.Context, listID string, plan QueryPlan) ([]FeedResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(twitterListTweetsURLTemplate, url.PathEscape(listID)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error building Twitter list request: %w", err)
+	}
+	q := url.Values{}
+	q.Set("max_results", "10")
+	q.Set("tweet.fields", "created_at,author_id,public_metrics")
+	q.Set("expansions", "author_id")
+	q.Set("user.fields", "profile_image_url,username")
+	req.URL.RawQuery = q.Encode()
+	req.Header.Set("Authorization", "Bearer "+appConfig.TwitterBearerToken)
+
+	resp, err := sharedHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling Twitter list tweets: %w", err)
+	}
+	defer resp.Body.Close()
+
+	logTwitterRateLimitHeaders(resp)
+
+	var parsed twitterV2SearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("error decoding Twitter list tweets response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		if len(parsed.Errors) > 0 {
+			return nil, fmt.Errorf("twitter list tweets failed (%d): %s", resp.StatusCode, parsed.Errors[0].Detail)
+		}
+		return nil, fmt.Errorf("twitter list tweets failed with status %d", resp.StatusCode)
+	}
+
+	var results []FeedResult
+	for _, result := range twitterV2ResponseToResults(parsed) {
+		if plan.matchesResidualFilter(result.Description) {
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// twitterV2ResponseToResults normalizes a v2 tweets response (shared by
+// recent search and list tweets, which return identical shapes) into
+// FeedResults, resolving each tweet's author_id against the response's
+// expanded user objects.
+func twitterV2ResponseToResults(parsed twitterV2SearchResponse) []FeedResult {
+	users := make(map[string]struct{ Username, ProfileImageURL string }, len(parsed.Includes.Users))
+	for _, user := range parsed.Includes.Users {
+		users[user.ID] = struct{ Username, ProfileImageURL string }{user.Username, user.ProfileImageURL}
+	}
+
+	var results []FeedResult
+	for _, tweet := range parsed.Data {
+		author := users[tweet.AuthorID]
+
+		published, err := time.Parse(time.RFC3339, tweet.CreatedAt)
+		if err != nil {
+			slog.Error(fmt.Sprintf("Error parsing tweet timestamp: %s", err))
+			published = time.Now()
+		}
+
+		results = append(results, FeedResult{
+			ID:            tweet.ID,
+			Title:         fmt.Sprintf("Tweet by @%s", author.Username),
+			Link:          fmt.Sprintf("https://twitter.com/%s/status/%s", author.Username, tweet.ID),
+			Published:     published.Format("2006-01-02 15:04:05"),
+			PublishedTime: published,
+			Description:   tweet.Text,
+			Source:        "Twitter",
+			Thumbnail:     author.ProfileImageURL,
+			Author: resultAuthor{
+				Name:       author.Username,
+				Handle:     "@" + author.Username,
+				ProfileURL: "https://twitter.com/" + author.Username,
+			},
+			Engagement: engagementMetrics{
+				Likes:    tweet.PublicMetrics.LikeCount,
+				Shares:   tweet.PublicMetrics.RetweetCount,
+				Comments: tweet.PublicMetrics.ReplyCount,
+			},
+		})
+	}
+	return results
+}
+
+// logTwitterRateLimitHeaders surfaces X-Rate-Limit-Remaining/Reset so an
+// operator sees the quota tightening in logs well before a 429 shows up.
+func logTwitterRateLimitHeaders(resp *http.Response) {
+	remaining := resp.Header.Get("x-rate-limit-remaining")
+	if remaining == "" {
+		return
+	}
+	n, err := strconv.Atoi(remaining)
+	if err != nil || n > 5 {
+		return
+	}
+	resetAt := resp.Header.Get("x-rate-limit-reset")
+	slog.Warn(fmt.Sprintf("Twitter recent search quota low: %s requests remaining, resets at %s", remaining, resetAt))
+}
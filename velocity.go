@@ -0,0 +1,55 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// velocityWindow is how far back sightings are counted when computing a
+// story's spread rate.
+const velocityWindow = time.Hour
+
+var (
+	storySightings     = make(map[uint64][]time.Time)
+	storySightingsLock sync.Mutex
+)
+
+// recordStorySighting notes that a story (identified by its content
+// fingerprint) was seen again right now, e.g. another social post linking
+// to it.
+func recordStorySighting(fingerprint uint64) {
+	storySightingsLock.Lock()
+	defer storySightingsLock.Unlock()
+	storySightings[fingerprint] = append(storySightings[fingerprint], time.Now())
+}
+
+// storyVelocityPerHour returns how many times a story has been sighted in
+// the last velocityWindow, i.e. its current shares/hour rate.
+func storyVelocityPerHour(fingerprint uint64) int {
+	cutoff := time.Now().Add(-velocityWindow)
+
+	storySightingsLock.Lock()
+	defer storySightingsLock.Unlock()
+
+	sightings := storySightings[fingerprint]
+	count := 0
+	kept := sightings[:0]
+	for _, t := range sightings {
+		if t.After(cutoff) {
+			count++
+			kept = append(kept, t)
+		}
+	}
+	storySightings[fingerprint] = kept
+	return count
+}
+
+// annotateVelocity records a sighting for every result and attaches its
+// current velocity so rapidly spreading stories can be ranked up.
+func annotateVelocity(results []FeedResult) []FeedResult {
+	for i := range results {
+		recordStorySighting(results[i].Fingerprint)
+		results[i].Velocity = storyVelocityPerHour(results[i].Fingerprint)
+	}
+	return results
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WatchlistEntry lets a specific keyword override the global defaults used
+// when it's searched, instead of every keyword being fetched identically.
+// Zero values mean "use the global default": an empty Sources falls back to
+// whatever the request itself specifies (or every source, if neither sets
+// one), and a zero ResultLimit leaves results untrimmed.
+type WatchlistEntry struct {
+	// Sources is a source group, e.g. "tag:finance", applied when the
+	// search request doesn't specify its own sources parameter.
+	Sources string `json:"sources,omitempty"`
+	// ResultLimit caps how many results per source are kept for this
+	// keyword's searches.
+	ResultLimit int `json:"resultLimit,omitempty"`
+	// Priority controls how the scheduler (scheduler.go, priority.go)
+	// paces this keyword's background crawls. Empty means "normal".
+	Priority keywordPriority `json:"priority,omitempty"`
+}
+
+// watchlistEntryFor returns keyword's watchlist override, if any.
+func watchlistEntryFor(keyword string) (WatchlistEntry, bool) {
+	appDBLock.Lock()
+	defer appDBLock.Unlock()
+
+	entry, ok := appDB.Watchlist[keyword]
+	return entry, ok
+}
+
+// applyWatchlistResultLimit trims each source's results to entry's
+// ResultLimit. If the keyword has no override (ResultLimit <= 0), it falls
+// back to the viewer's own resultsPerPage preference (preferences.go).
+func applyWatchlistResultLimit(entry WatchlistEntry, prefs UserPreferences, results map[string][]FeedResult) {
+	limit := entry.ResultLimit
+	if limit <= 0 {
+		limit = effectiveResultsPerPage(prefs)
+	}
+	if limit <= 0 {
+		return
+	}
+	for source, sourceResults := range results {
+		if len(sourceResults) > limit {
+			results[source] = sourceResults[:limit]
+		}
+	}
+}
+
+func watchlistHandler(c *gin.Context) {
+	appDBLock.Lock()
+	entries := appDB.Watchlist
+	appDBLock.Unlock()
+
+	c.JSON(http.StatusOK, gin.H{"watchlist": entries})
+}
+
+func setWatchlistEntryHandler(c *gin.Context) {
+	keyword := c.Param("keyword")
+	if keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is required"})
+		return
+	}
+
+	var entry WatchlistEntry
+	if err := c.ShouldBindJSON(&entry); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	appDBLock.Lock()
+	if appDB.Watchlist == nil {
+		appDB.Watchlist = make(map[string]WatchlistEntry)
+	}
+	appDB.Watchlist[keyword] = entry
+	appDBLock.Unlock()
+
+	if err := saveAppDB(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"keyword": keyword, "watchlist": entry})
+}
+
+func deleteWatchlistEntryHandler(c *gin.Context) {
+	keyword := c.Param("keyword")
+
+	appDBLock.Lock()
+	delete(appDB.Watchlist, keyword)
+	appDBLock.Unlock()
+
+	if err := saveAppDB(); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
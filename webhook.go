@@ -0,0 +1,62 @@
+package main
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// authenticateWebhook checks X-Webhook-Secret against appConfig.WebhookSecret.
+// Unlike viewerRole/viewerID (roles.go, preferences.go), which just label a
+// caller, this gates a mutating action against arbitrary external systems,
+// so it's a real shared-secret comparison rather than a self-reported
+// header. An unconfigured secret disables the endpoint entirely rather than
+// accepting every caller.
+func authenticateWebhook(c *gin.Context) bool {
+	if appConfig == nil || appConfig.WebhookSecret == "" {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "webhook crawls are not configured (WEBHOOK_SECRET unset)"})
+		return false
+	}
+
+	provided := c.GetHeader("X-Webhook-Secret")
+	if subtle.ConstantTimeCompare([]byte(provided), []byte(appConfig.WebhookSecret)) != 1 {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid webhook secret"})
+		return false
+	}
+	return true
+}
+
+// webhookCrawlHandler lets an authenticated external system (e.g. the PR
+// team's publishing pipeline) trigger an immediate crawl of a keyword,
+// optionally restricted to one source, without waiting for the next
+// scheduled crawl (scheduler.go). It reuses the same async job subsystem as
+// POST /crawl/jobs, so the caller polls GET /crawl/jobs/:id for the result
+// exactly like any other crawl job.
+func webhookCrawlHandler(c *gin.Context) {
+	if !authenticateWebhook(c) {
+		return
+	}
+
+	var body struct {
+		Keyword string `json:"keyword"`
+		Source  string `json:"source"`
+	}
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if body.Keyword == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "keyword is required"})
+		return
+	}
+	if body.Source != "" {
+		if _, ok := sourceRegistry[body.Source]; !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "unknown source"})
+			return
+		}
+	}
+
+	job := crawlJobs.Submit(body.Keyword, body.Source)
+	c.JSON(http.StatusAccepted, job)
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// This file generalizes instagram.go's mentions webhook to every owned
+// account this aggregator monitors via Meta's webhook platform, plus
+// Facebook's own Page mentions/comments callback — real-time ingestion for
+// accounts we own, as opposed to the keyword polling everything else in
+// this file does for accounts we don't. "other platforms" from the request
+// title is scoped to what this repo already integrates with (Meta); adding
+// a receiver for a platform with no existing integration here would have
+// nothing to feed into.
+
+// verifyMetaWebhookSignature checks the X-Hub-Signature-256 header Meta
+// attaches to every webhook callback: an HMAC-SHA256 of the raw request
+// body keyed by the app secret. Unlike authenticateWebhook's shared-secret
+// header (webhook.go), Meta signs the body itself, so a proxy or logging
+// layer that captured a header couldn't replay a forged payload.
+func verifyMetaWebhookSignature(secret string, body []byte, signatureHeader string) bool {
+	if secret == "" || signatureHeader == "" {
+		return false
+	}
+	const prefix = "sha256="
+	if !strings.HasPrefix(signatureHeader, prefix) {
+		return false
+	}
+	expected := hmac.New(sha256.New, []byte(secret))
+	expected.Write(body)
+	expectedHex := hex.EncodeToString(expected.Sum(nil))
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(signatureHeader, prefix)), []byte(expectedHex)) == 1
+}
+
+// verifyMetaWebhookRequest reads and restores the request body (Gin's JSON
+// binding needs it intact afterward) and checks it against
+// MetaAppSecret, when one is configured. An unconfigured secret is treated
+// as "signature checking not opted into" rather than "reject everything,"
+// matching InstagramAccessToken/FacebookAppToken's optional-credential
+// convention elsewhere in config.go — the verify-token check on the GET
+// handshake is what actually gates subscription in that case.
+func verifyMetaWebhookRequest(c *gin.Context) bool {
+	if appConfig.MetaAppSecret == "" {
+		return true
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
+	}
+	c.Request.Body = io.NopCloser(strings.NewReader(string(body)))
+
+	if !verifyMetaWebhookSignature(appConfig.MetaAppSecret, body, c.GetHeader("X-Hub-Signature-256")) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid webhook signature"})
+		return false
+	}
+	return true
+}
+
+// facebookMentionWebhookHandler receives Meta's Page mentions/comments
+// webhook for Pages this account administers, the Facebook counterpart to
+// instagramMentionWebhookHandler.
+func facebookMentionWebhookHandler(c *gin.Context) {
+	if c.Request.Method == http.MethodGet {
+		if c.Query("hub.verify_token") != appConfig.FacebookWebhookVerifyToken || appConfig.FacebookWebhookVerifyToken == "" {
+			c.String(http.StatusForbidden, "verification token mismatch")
+			return
+		}
+		c.String(http.StatusOK, "%s", c.Query("hub.challenge"))
+		return
+	}
+
+	if !verifyMetaWebhookRequest(c) {
+		return
+	}
+
+	var payload struct {
+		Entry []struct {
+			Changes []struct {
+				Field string `json:"field"`
+				Value struct {
+					PostID    string `json:"post_id"`
+					CommentID string `json:"comment_id"`
+					Message   string `json:"message"`
+				} `json:"value"`
+			} `json:"changes"`
+		} `json:"entry"`
+	}
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	for _, entry := range payload.Entry {
+		for _, change := range entry.Changes {
+			if change.Field != "mention" && change.Field != "feed" {
+				continue
+			}
+			result := FeedResult{
+				ID:            change.Value.CommentID,
+				Title:         "Facebook mention",
+				Link:          fmt.Sprintf("https://www.facebook.com/%s", change.Value.PostID),
+				Published:     time.Now().Format("2006-01-02 15:04:05"),
+				PublishedTime: time.Now(),
+				Description:   change.Value.Message,
+				Source:        "Facebook",
+			}
+			recordSearchHistory("facebook-mentions", map[string][]FeedResult{"Facebook": {result}})
+			notifyIfNew("facebook-mentions", result)
+		}
+	}
+	c.Status(http.StatusOK)
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/youtube/v3"
+)
+
+// youtubeChannelWatchlistFile lists channels to poll for live streams and
+// premieres, the same standing-watch shape reddit_watchlist.json uses for
+// subreddits: this isn't scoped to a search keyword, so it can't live in
+// appDB.Watchlist.
+const youtubeChannelWatchlistFile = "youtube_channels.json"
+
+const youtubeLiveWatchDefaultInterval = 5 * time.Minute
+
+var youtubeLiveWatchInterval = youtubeLiveWatchDefaultInterval
+
+// YouTubeChannelWatch is one channel an operator wants checked for live
+// streams and premieres. Keywords is optional: empty means "flag every
+// live event," matching RedditWatchEntry's empty-Keywords convention.
+type YouTubeChannelWatch struct {
+	ChannelID string   `json:"channelId"`
+	Keywords  []string `json:"keywords,omitempty"`
+	// SampleChat opts into fetching a handful of live chat messages for
+	// keyword matching, since polling chat is extra quota cost
+	// (liveChatMessages.list) an operator may not want on every channel.
+	SampleChat bool `json:"sampleChat,omitempty"`
+}
+
+func loadYouTubeLiveWatchInterval() {
+	if raw := os.Getenv("YOUTUBE_LIVE_WATCH_INTERVAL_SECONDS"); raw != "" {
+		if n, err := strconv.Atoi(raw); err == nil && n > 0 {
+			youtubeLiveWatchInterval = time.Duration(n) * time.Second
+		}
+	}
+}
+
+func loadYouTubeChannelWatchlist() ([]YouTubeChannelWatch, error) {
+	file, err := os.Open(youtubeChannelWatchlistFile)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening YouTube channel watchlist: %w", err)
+	}
+	defer file.Close()
+
+	var data struct {
+		Channels []YouTubeChannelWatch `json:"channels"`
+	}
+	if err := json.NewDecoder(file).Decode(&data); err != nil {
+		return nil, fmt.Errorf("error decoding YouTube channel watchlist: %w", err)
+	}
+	return data.Channels, nil
+}
+
+// startYouTubeLiveWatchJob starts the background poll loop, the same
+// ticker shape startRedditWatchJob and startScheduledCrawler use.
+func startYouTubeLiveWatchJob() {
+	go func() {
+		ticker := time.NewTicker(youtubeLiveWatchInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			runYouTubeLiveWatchPoll()
+		}
+	}()
+}
+
+func runYouTubeLiveWatchPoll() {
+	watches, err := loadYouTubeChannelWatchlist()
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error loading YouTube channel watchlist: %s", err))
+		return
+	}
+	if len(watches) == 0 {
+		return
+	}
+
+	service, err := youtube.NewService(context.Background(), option.WithAPIKey(appConfig.YouTubeAPIKey), option.WithHTTPClient(sharedHTTPClient))
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error creating YouTube service for live watch: %s", err))
+		return
+	}
+
+	for _, watch := range watches {
+		pollYouTubeChannel(service, watch)
+	}
+}
+
+func pollYouTubeChannel(service *youtube.Service, watch YouTubeChannelWatch) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error(fmt.Sprintf("Recovered from panic polling YouTube channel %q: %v", watch.ChannelID, r))
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), schedulerCrawlTimeout)
+	defer cancel()
+
+	results, err := fetchYouTubeLiveEvents(ctx, service, watch)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error checking YouTube channel %q for live events: %s", watch.ChannelID, err))
+		return
+	}
+	results = filterUnsafeResults(results)
+
+	watchKey := "youtube-live:" + watch.ChannelID
+	recordSearchHistory(watchKey, map[string][]FeedResult{"YouTube": results})
+	for _, result := range results {
+		notifyIfNew(watchKey, result)
+	}
+}
+
+// fetchYouTubeLiveEvents lists watch.ChannelID's current live broadcasts
+// and upcoming premieres, flags each as a live event, and — when
+// watch.SampleChat is set — samples a live broadcast's chat for messages
+// matching watch.Keywords.
+func fetchYouTubeLiveEvents(ctx context.Context, service *youtube.Service, watch YouTubeChannelWatch) ([]FeedResult, error) {
+	var results []FeedResult
+	for _, eventType := range []string{"live", "upcoming"} {
+		call := service.Search.List([]string{"id", "snippet"}).
+			Context(ctx).
+			ChannelId(watch.ChannelID).
+			EventType(eventType).
+			Type("video").
+			MaxResults(5)
+
+		response, err := call.Do()
+		if err != nil {
+			recordSourceError("YouTube", err)
+			return results, fmt.Errorf("error listing %s broadcasts for channel %q: %w", eventType, watch.ChannelID, err)
+		}
+		recordSourceError("YouTube", nil)
+		recordCost("youtube_quota", watch.ChannelID, 100)
+
+		for _, item := range response.Items {
+			text := item.Snippet.Title + " " + item.Snippet.Description
+			if !redditKeywordMatches(watch.Keywords, text) {
+				continue
+			}
+
+			published, _ := time.Parse(time.RFC3339, item.Snippet.PublishedAt)
+			result := FeedResult{
+				ID:            item.Id.VideoId,
+				Title:         fmt.Sprintf("[%s] %s", strings.ToUpper(eventType), item.Snippet.Title),
+				Link:          fmt.Sprintf("https://www.youtube.com/watch?v=%s", item.Id.VideoId),
+				Published:     published.Format("2006-01-02 15:04:05"),
+				PublishedTime: published,
+				Description:   item.Snippet.Description,
+				Source:        "YouTube",
+				Thumbnail:     item.Snippet.Thumbnails.Default.Url,
+				LiveEvent:     true,
+			}
+
+			if watch.SampleChat && eventType == "live" {
+				if sample, err := sampleYouTubeLiveChat(ctx, service, item.Id.VideoId, watch.Keywords); err != nil {
+					slog.Error(fmt.Sprintf("Error sampling live chat for video %s: %s", item.Id.VideoId, err))
+				} else {
+					result.LiveChatSample = sample
+				}
+			}
+
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// sampleYouTubeLiveChat fetches one page of a live broadcast's chat and
+// returns the matching messages joined into a single string, bounded to a
+// handful of lines so a busy chat doesn't blow up FeedResult size — this
+// is a sample for alerting context, not a transcript.
+func sampleYouTubeLiveChat(ctx context.Context, service *youtube.Service, videoID string, keywords []string) (string, error) {
+	video, err := service.Videos.List([]string{"liveStreamingDetails"}).Context(ctx).Id(videoID).Do()
+	if err != nil {
+		return "", fmt.Errorf("error fetching live streaming details for video %s: %w", videoID, err)
+	}
+	if len(video.Items) == 0 || video.Items[0].LiveStreamingDetails == nil || video.Items[0].LiveStreamingDetails.ActiveLiveChatId == "" {
+		return "", nil
+	}
+	liveChatID := video.Items[0].LiveStreamingDetails.ActiveLiveChatId
+
+	chat, err := service.LiveChatMessages.List(liveChatID, []string{"snippet", "authorDetails"}).Context(ctx).MaxResults(50).Do()
+	if err != nil {
+		return "", fmt.Errorf("error listing live chat messages for %s: %w", liveChatID, err)
+	}
+
+	var matches []string
+	for _, item := range chat.Items {
+		text := item.Snippet.DisplayMessage
+		if redditKeywordMatches(keywords, text) {
+			matches = append(matches, fmt.Sprintf("%s: %s", item.AuthorDetails.DisplayName, text))
+			if len(matches) >= 5 {
+				break
+			}
+		}
+	}
+	return strings.Join(matches, " | "), nil
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	"github.com/mmcdole/gofeed"
+)
+
+// fetchYouTubeFeedsViaRSS is the no-key fallback fetchYouTubeFeeds uses
+// once every pooled API key (apikeypool.go) has quotaExceeded, so the
+// YouTube section degrades to fewer/older-looking results instead of
+// disappearing entirely. YouTube's feeds/videos.xml endpoint is
+// officially documented for channel_id/playlist_id/user; search_query is
+// undocumented and can stop working without notice, so this is a
+// best-effort fallback, not a guaranteed replacement for the real API.
+func fetchYouTubeFeedsViaRSS(ctx context.Context, keyword string) []FeedResult {
+	feedURL := "https://www.youtube.com/feeds/videos.xml?search_query=" + url.QueryEscape(keyword)
+	return fetchYouTubeRSSURL(ctx, feedURL, "YouTube")
+}
+
+// fetchYouTubeChannelRSS fetches a channel's official RSS feed — the
+// no-key mechanism youtubelive.go's watchlist could fall back to for a
+// channel it's already watching, the same way fetchYouTubeFeedsViaRSS
+// falls back for keyword search.
+func fetchYouTubeChannelRSS(ctx context.Context, channelID string) []FeedResult {
+	feedURL := "https://www.youtube.com/feeds/videos.xml?channel_id=" + url.QueryEscape(channelID)
+	return fetchYouTubeRSSURL(ctx, feedURL, "YouTube")
+}
+
+func fetchYouTubeRSSURL(ctx context.Context, feedURL, source string) []FeedResult {
+	fp := gofeed.NewParser()
+	fp.Client = sharedHTTPClient
+	feed, err := fp.ParseURLWithContext(feedURL, ctx)
+	if err != nil {
+		slog.Error(fmt.Sprintf("Error fetching YouTube RSS fallback feed %s: %s", feedURL, err))
+		recordSourceError(source, err)
+		return nil
+	}
+	recordSourceError(source, nil)
+
+	var results []FeedResult
+	for _, item := range feed.Items {
+		result := FeedResult{
+			Title:       normalizeFeedText(item.Title),
+			Link:        item.Link,
+			Description: normalizeFeedText(item.Description),
+			Source:      source,
+		}
+		if item.PublishedParsed != nil {
+			result.PublishedTime = *item.PublishedParsed
+			result.Published = result.PublishedTime.Format("2006-01-02 15:04:05")
+		}
+		if item.Image != nil {
+			result.Thumbnail = item.Image.URL
+		}
+		results = append(results, result)
+	}
+
+	slog.Info(fmt.Sprintf("Fetched %d items from YouTube RSS fallback (%s)", len(results), feedURL))
+	return results
+}